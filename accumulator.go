@@ -0,0 +1,169 @@
+package price
+
+import (
+	"math/big"
+)
+
+// Accumulator sums prices in place, e.g. to total a cart or thousands of rows without allocating a new Price per addition.
+// The sum is exact and rounded once by Result to the highest precision of the added amounts.
+// It follows the currency rules of Price.Add: an empty sum takes the currency of the added price and zero prices in other currencies are ignored.
+// With SetStrictCurrencyGuard only an empty sum without currency takes the currency of the added price.
+// The zero value is an empty sum without currency, an Accumulator must not be copied or used concurrently
+type Accumulator struct {
+	// units is the exact sum in units of 2^-shift
+	units    big.Int
+	shift    int
+	prec     uint
+	inf      int
+	currency string
+	// buffers reused between additions
+	scaled  big.Float
+	integer big.Int
+	qty     big.Int
+}
+
+// NewAccumulator returns an empty sum in the currency
+func NewAccumulator(currency string) *Accumulator {
+	return &Accumulator{currency: currency}
+}
+
+// Add adds the price to the sum, prices in other currencies return an error and leave the sum unchanged.
+// An infinite price with the opposite sign of an infinite sum returns ErrNotFinite and leaves the sum unchanged
+func (a *Accumulator) Add(p Price) error {
+	if err := a.guard(p); err != nil {
+		return err
+	}
+	if err := a.checkInf(&p.amount, 1); err != nil {
+		return err
+	}
+	if a.load(&p.amount, 1) {
+		a.units.Add(&a.units, &a.integer)
+	}
+	a.grow(p.amount.Prec())
+	return nil
+}
+
+// Sub subtracts the price from the sum, prices in other currencies return an error and leave the sum unchanged.
+// Subtracting an infinite price from an infinite sum of the same sign returns ErrNotFinite
+func (a *Accumulator) Sub(p Price) error {
+	if err := a.guard(p); err != nil {
+		return err
+	}
+	if err := a.checkInf(&p.amount, -1); err != nil {
+		return err
+	}
+	if a.load(&p.amount, -1) {
+		a.units.Sub(&a.units, &a.integer)
+	}
+	a.grow(p.amount.Prec())
+	return nil
+}
+
+// AddMultiplied adds price * qty to the sum like Price.MultiplyInt64, e.g. for the lines of a cart
+func (a *Accumulator) AddMultiplied(p Price, qty int64) error {
+	if err := a.guard(p); err != nil {
+		return err
+	}
+	if qty == 0 {
+		// also avoids Inf * 0, which is not defined
+		return nil
+	}
+	if err := a.checkInf(&p.amount, qty); err != nil {
+		return err
+	}
+	if a.load(&p.amount, qty) {
+		a.units.Add(&a.units, a.integer.Mul(&a.integer, a.qty.SetInt64(qty)))
+	}
+	a.grow(p.amount.Prec() + 64)
+	return nil
+}
+
+// Result returns the current sum as Price
+func (a *Accumulator) Result() Price {
+	amount := new(big.Float).SetPrec(a.prec)
+	if a.inf != 0 {
+		amount.SetInf(a.inf < 0)
+	} else if a.prec > 0 {
+		amount.SetMantExp(amount.SetInt(&a.units), -a.shift)
+	}
+	return Price{
+		amount:   *amount,
+		currency: a.currency,
+	}
+}
+
+// Reset empties the sum and sets the currency
+func (a *Accumulator) Reset(currency string) {
+	a.units.SetInt64(0)
+	a.shift, a.prec, a.inf = 0, 0, 0
+	a.currency = currency
+}
+
+// guard applies the currency rules of Price.currencyGuard to the sum
+func (a *Accumulator) guard(p Price) error {
+	switch {
+	case a.currency == p.currency:
+		return nil
+	case IsStrictCurrencyGuard():
+		if a.currency == "" && a.units.Sign() == 0 && a.inf == 0 {
+			a.currency = p.currency
+			return nil
+		}
+	case a.units.Sign() == 0 && a.inf == 0:
+		a.currency = p.currency
+		return nil
+	case p.IsZero():
+		return nil
+	}
+	return ErrCurrencyMismatch
+}
+
+// checkInf returns ErrNotFinite if x * factor is infinite with the opposite sign of an infinite sum,
+// the result would be NaN which cannot be represented
+func (a *Accumulator) checkInf(x *big.Float, factor int64) error {
+	if !x.IsInf() || a.inf == 0 {
+		return nil
+	}
+	sign := x.Sign()
+	if factor < 0 {
+		sign = -sign
+	}
+	if sign != a.inf {
+		return newDetailedError(ErrNotFinite, "cannot add infinite amounts with opposite signs")
+	}
+	return nil
+}
+
+// load sets a.integer to x in units of the sum, the sum is rescaled if x has more binary digits.
+// Infinite amounts are tracked with the sign of x * factor, in that case false is returned.
+// checkInf must be called before
+func (a *Accumulator) load(x *big.Float, factor int64) bool {
+	if x.IsInf() {
+		sign := x.Sign()
+		if factor < 0 {
+			sign = -sign
+		}
+		a.inf = sign
+		return false
+	}
+	if x.Sign() == 0 {
+		a.integer.SetInt64(0)
+		return true
+	}
+
+	// x = m * 2^(exp - minPrec) with an integer m, so x * 2^shift is an integer for shift >= minPrec - exp
+	if shift := int(x.MinPrec()) - x.MantExp(nil); shift > a.shift {
+		a.units.Lsh(&a.units, uint(shift-a.shift))
+		a.shift = shift
+	}
+	a.scaled.SetPrec(x.Prec())
+	a.scaled.SetMantExp(x, a.shift).Int(&a.integer)
+	return true
+}
+
+// grow raises the precision of the result
+func (a *Accumulator) grow(prec uint) {
+	if prec > a.prec {
+		a.prec = prec
+	}
+}
@@ -0,0 +1,107 @@
+package price_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestAccumulator(t *testing.T) {
+	sum := price.NewAccumulator("EUR")
+	require.NoError(t, sum.Add(price.NewFromInt(1000, 100, "EUR")))
+	require.NoError(t, sum.AddMultiplied(price.NewFromInt(250, 100, "EUR"), 3))
+	require.NoError(t, sum.Sub(price.NewFromInt(50, 100, "EUR")))
+	assert.True(t, sum.Result().LikelyEqual(price.NewFromInt(1700, 100, "EUR")))
+
+	assert.Error(t, sum.Add(price.NewFromInt(1, 100, "USD")))
+	assert.NoError(t, sum.Add(price.NewZero("USD")), "zero prices in other currencies are ignored")
+	assert.Equal(t, "EUR", sum.Result().Currency())
+	assert.True(t, sum.Result().LikelyEqual(price.NewFromInt(1700, 100, "EUR")))
+
+	sum.Reset("EUR")
+	assert.True(t, sum.Result().IsZero())
+	require.NoError(t, sum.Add(price.NewFromInt(1, 1, "USD")), "an empty sum takes the currency")
+	assert.Equal(t, "USD", sum.Result().Currency())
+
+	result := sum.Result()
+	require.NoError(t, sum.Add(price.NewFromInt(1, 1, "USD")))
+	assert.True(t, result.Equal(price.NewFromInt(1, 1, "USD")), "results are not changed by later additions")
+}
+
+func TestAccumulator_MatchesAdd(t *testing.T) {
+	var sum price.Accumulator
+	expected := price.NewFromFloat(0.1, "EUR")
+	require.NoError(t, sum.Add(expected))
+	for i := 1; i < 100; i++ {
+		p := price.NewFromFloat(float64(i)*0.1, "EUR")
+		require.NoError(t, sum.Add(p))
+		expected, _ = expected.Add(p)
+	}
+	assert.True(t, sum.Result().LikelyEqual(expected))
+}
+
+func benchmarkRows() []price.Price {
+	rows := make([]price.Price, 10000)
+	for i := range rows {
+		rows[i] = price.NewFromInt(int64(100+i), 100, "EUR")
+	}
+	return rows
+}
+
+func BenchmarkPrice_AddLoop(b *testing.B) {
+	rows := benchmarkRows()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		total := price.NewZero("EUR")
+		for _, row := range rows {
+			total, _ = total.Add(row.Multiply(2))
+		}
+	}
+}
+
+func BenchmarkAccumulator(b *testing.B) {
+	rows := benchmarkRows()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		total := price.NewAccumulator("EUR")
+		for _, row := range rows {
+			_ = total.AddMultiplied(row, 2)
+		}
+		_ = total.Result()
+	}
+}
+
+func BenchmarkSumAll(b *testing.B) {
+	rows := benchmarkRows()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = price.SumAll(rows...)
+	}
+}
+
+func TestAccumulator_Infinite(t *testing.T) {
+	inf := price.NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+
+	sum := price.NewAccumulator("EUR")
+	require.NoError(t, sum.Add(price.NewFromInt(1, 1, "EUR")))
+	require.NoError(t, sum.Add(inf))
+	assert.True(t, sum.Result().Amount().IsInf())
+
+	require.NoError(t, sum.AddMultiplied(inf, 0))
+	assert.ErrorIs(t, sum.Sub(inf), price.ErrNotFinite)
+	assert.ErrorIs(t, sum.AddMultiplied(inf, -2), price.ErrNotFinite)
+	negative := price.NewFromBigFloat(*new(big.Float).SetInf(true), "EUR")
+	assert.ErrorIs(t, sum.Add(negative), price.ErrNotFinite)
+	assert.True(t, sum.Result().Amount().IsInf())
+	assert.Equal(t, 1, sum.Result().Amount().Sign(), "the sum is unchanged")
+
+	_, err := price.SumAll(inf, negative)
+	assert.ErrorIs(t, err, price.ErrNotFinite)
+}
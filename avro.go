@@ -0,0 +1,103 @@
+package price
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// AvroSchema is the Avro schema of the binary encoding of MarshalAvro, the amount uses the decimal logical type
+// with 9 decimal places like google.type.Money, e.g. for a schema registry subject of order events
+const AvroSchema = `{"type":"record","name":"Price","namespace":"com.github.maohieng.price","fields":[` +
+	`{"name":"amount","type":{"type":"bytes","logicalType":"decimal","precision":38,"scale":9}},` +
+	`{"name":"currency","type":"string"}]}`
+
+const (
+	avroScale     = 9
+	avroPrecision = 38
+)
+
+// MarshalAvro returns the Avro binary encoding of the price with AvroSchema.
+// Digits beyond the scale are rounded half up, an error is returned if the amount exceeds the precision
+func (p Price) MarshalAvro() ([]byte, error) {
+	if p.amount.IsInf() {
+		return nil, ErrNotFinite
+	}
+	amount, _ := p.amount.Rat(nil)
+	unscaled := roundRat(amount.Mul(amount, new(big.Rat).SetInt(avroScaleFactor())), RoundingModeHalfUp)
+	if len(new(big.Int).Abs(unscaled).String()) > avroPrecision {
+		return nil, errors.New("amount exceeds the precision of the Avro decimal")
+	}
+
+	amountBytes := twosComplement(unscaled)
+	data := appendAvroBytes(nil, amountBytes)
+	return appendAvroBytes(data, []byte(p.currency)), nil
+}
+
+// UnmarshalAvro reads the Avro binary encoding of the price with AvroSchema
+func (p *Price) UnmarshalAvro(data []byte) error {
+	amountBytes, rest, err := avroBytes(data)
+	if err != nil {
+		return err
+	}
+	currency, rest, err := avroBytes(rest)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return errors.New("unexpected data after Avro price")
+	}
+
+	unscaled := new(big.Int).SetBytes(amountBytes)
+	if len(amountBytes) > 0 && amountBytes[0]&0x80 != 0 {
+		// negative two's complement value
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(8*len(amountBytes))))
+	}
+	parsed, err := newFromDecimalParts(unscaled, -avroScale, string(currency))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+func avroScaleFactor() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(avroScale), nil)
+}
+
+// appendAvroBytes appends bytes or a string with the length as zigzag varint
+func appendAvroBytes(data []byte, b []byte) []byte {
+	var length [binary.MaxVarintLen64]byte
+	data = append(data, length[:binary.PutVarint(length[:], int64(len(b)))]...)
+	return append(data, b...)
+}
+
+// avroBytes reads bytes or a string, the length is a zigzag varint
+func avroBytes(data []byte) ([]byte, []byte, error) {
+	n, size := binary.Varint(data)
+	if size <= 0 || n < 0 || n > int64(len(data)-size) {
+		return nil, nil, errors.New("invalid Avro bytes")
+	}
+	end := size + int(n)
+	return data[size:end], data[end:], nil
+}
+
+// twosComplement returns the shortest big endian two's complement of n
+func twosComplement(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		b := n.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -n - 1 has the inverted bits of n
+	inverted := new(big.Int).Sub(new(big.Int).Neg(n), big.NewInt(1)).Bytes()
+	if len(inverted) == 0 || inverted[0]&0x80 != 0 {
+		inverted = append([]byte{0}, inverted...)
+	}
+	for i := range inverted {
+		inverted[i] = ^inverted[i]
+	}
+	return inverted
+}
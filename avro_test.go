@@ -0,0 +1,73 @@
+package price
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_MarshalAvro(t *testing.T) {
+	tests := []struct {
+		price Price
+		want  string
+	}{
+		// 12.34 is unscaled 12340000000 = 0x02df857500, length 5 is zigzag 0x0a
+		{price: NewFromInt(1234, 100, "EUR"), want: "0a02df857500" + "06455552"},
+		// -1 is unscaled -1000000000 = 0xc4653600
+		{price: NewFromInt(-1, 1, "USD"), want: "08c4653600" + "06555344"},
+		{price: NewZero(""), want: "0200" + "00"},
+		{price: NewFromFloat(0.0000000005, "EUR"), want: "0201" + "06455552"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.price.String(), func(t *testing.T) {
+			data, err := tt.price.MarshalAvro()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, hex.EncodeToString(data))
+		})
+	}
+
+	_, err := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR").MarshalAvro()
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = NewFromFloat(1e30, "EUR").MarshalAvro()
+	assert.Error(t, err)
+}
+
+func TestPrice_UnmarshalAvro(t *testing.T) {
+	for _, original := range []Price{
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-1, 1, "USD"),
+		NewFromInt(-128, 1000000000, "USD"),
+		NewFromInt(128, 1000000000, "USD"),
+		NewFromFloat(0.1, "JPY"),
+		NewZero(""),
+	} {
+		data, err := original.MarshalAvro()
+		require.NoError(t, err)
+		var decoded Price
+		require.NoError(t, decoded.UnmarshalAvro(data))
+		assert.Equal(t, original.canonicalAmount(), decoded.canonicalAmount())
+		assert.Equal(t, original.Currency(), decoded.Currency())
+	}
+
+	var p Price
+	for _, data := range []string{"", "0a02df", "0200", "020006455552ff", "01"} {
+		b, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		assert.Error(t, p.UnmarshalAvro(b), data)
+	}
+}
+
+func TestAvroSchema(t *testing.T) {
+	assert.JSONEq(t, `{
+		"type": "record",
+		"name": "Price",
+		"namespace": "com.github.maohieng.price",
+		"fields": [
+			{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 38, "scale": 9}},
+			{"name": "currency", "type": "string"}
+		]
+	}`, AvroSchema)
+}
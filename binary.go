@@ -0,0 +1,217 @@
+package price
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// binaryVersion is the version of the compact binary format of MarshalBinary, the first byte of the data.
+// The JSON of older versions starts with '{' and is still accepted by UnmarshalBinary
+const binaryVersion byte = 1
+
+// flags of the compact binary format
+const (
+	binaryNegative byte = 1 << iota
+	binaryInfinite
+	// binaryBigCoefficient marks a coefficient beyond uint64, it is written as length and big endian bytes
+	binaryBigCoefficient
+	// binaryInlineCurrency marks a currency that is not packed, it is written as length and bytes
+	binaryInlineCurrency
+)
+
+// marshalCompact returns the compact binary format: version, flags, precision (uvarint), exponent (varint),
+// coefficient (uvarint) and currency. The amount is coefficient * 2^exponent with an odd coefficient, so the price
+// is restored with the same value and precision. Three letter codes like "EUR" are packed into an uvarint of
+// 5 bits per letter, other currencies are written inline
+func (p Price) marshalCompact() []byte {
+	data := make([]byte, 2, 24)
+	data[0] = binaryVersion
+	if p.amount.Signbit() {
+		data[1] |= binaryNegative
+	}
+	data = appendUvarint(data, uint64(p.amount.Prec()))
+
+	if p.amount.IsInf() {
+		data[1] |= binaryInfinite
+	} else {
+		amount, _ := p.amount.Rat(nil)
+		coefficient := new(big.Int).Abs(amount.Num())
+		// the denominator of a finite big.Float is a power of two
+		exp := -(amount.Denom().BitLen() - 1)
+		if zeros := coefficient.TrailingZeroBits(); coefficient.Sign() != 0 && zeros > 0 {
+			coefficient.Rsh(coefficient, zeros)
+			exp += int(zeros)
+		}
+		data = appendVarint(data, int64(exp))
+		if coefficient.IsUint64() {
+			data = appendUvarint(data, coefficient.Uint64())
+		} else {
+			data[1] |= binaryBigCoefficient
+			data = appendLengthPrefixed(data, coefficient.Bytes())
+		}
+	}
+
+	if packed, ok := packCurrency(p.currency); ok {
+		return appendUvarint(data, packed)
+	}
+	data[1] |= binaryInlineCurrency
+	return appendLengthPrefixed(data, []byte(p.currency))
+}
+
+// unmarshalCompact reads the compact binary format of marshalCompact
+func (p *Price) unmarshalCompact(data []byte) error {
+	if len(data) < 2 || data[0] != binaryVersion {
+		return errors.New("unsupported binary price format")
+	}
+	flags := data[1]
+	r := &binaryReader{data: data[2:]}
+
+	amount, err := r.exactAmount(flags)
+	if err != nil {
+		return err
+	}
+	result := Price{amount: amount}
+
+	if flags&binaryInlineCurrency != 0 {
+		result.currency = string(r.lengthPrefixed())
+	} else {
+		result.currency = unpackCurrency(r.uvarint())
+	}
+	if r.err != nil {
+		return r.err
+	}
+	if len(r.data) > 0 {
+		return errors.New("unexpected data after binary price")
+	}
+	*p = result
+	return nil
+}
+
+// exactAmount reads the precision, exponent and coefficient of the amount
+func (r *binaryReader) exactAmount(flags byte) (big.Float, error) {
+	var amount big.Float
+	prec := r.uvarint()
+	if r.err == nil && prec > big.MaxPrec {
+		return amount, errors.New("binary price precision out of range")
+	}
+	amount.SetPrec(uint(prec))
+
+	if flags&binaryInfinite != 0 {
+		amount.SetInf(flags&binaryNegative != 0)
+		return amount, r.err
+	}
+	exp := r.varint()
+	coefficient := r.coefficient(flags)
+	if r.err != nil {
+		return amount, r.err
+	}
+	if exp < big.MinExp || exp > big.MaxExp || (prec == 0 && coefficient.Sign() != 0) {
+		return amount, errors.New("invalid binary price")
+	}
+	if uint64(coefficient.BitLen()) > prec {
+		return amount, errors.New("binary price coefficient exceeds the precision")
+	}
+	if coefficient.Sign() != 0 {
+		// SetInt keeps the precision and is exact since the coefficient fits into it
+		amount.SetInt(coefficient)
+		amount.SetMantExp(&amount, int(exp))
+	}
+	if flags&binaryNegative != 0 {
+		amount.Neg(&amount)
+	}
+	return amount, nil
+}
+
+// coefficient reads an uvarint or a length-prefixed big coefficient
+func (r *binaryReader) coefficient(flags byte) *big.Int {
+	if flags&binaryBigCoefficient != 0 {
+		return new(big.Int).SetBytes(r.lengthPrefixed())
+	}
+	return new(big.Int).SetUint64(r.uvarint())
+}
+
+// packCurrency packs a code of three upper case letters into 15 bits
+func packCurrency(currency string) (uint64, bool) {
+	if len(currency) != 3 {
+		return 0, false
+	}
+	var packed uint64
+	for i := 0; i < 3; i++ {
+		c := currency[i]
+		if c < 'A' || c > 'Z' {
+			return 0, false
+		}
+		packed = packed<<5 | uint64(c-'A'+1)
+	}
+	return packed, true
+}
+
+func unpackCurrency(packed uint64) string {
+	code := make([]byte, 3)
+	for i := 2; i >= 0; i-- {
+		code[i] = byte(packed&0x1f) + 'A' - 1
+		packed >>= 5
+	}
+	return string(code)
+}
+
+func appendUvarint(data []byte, v uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	return append(data, b[:binary.PutUvarint(b[:], v)]...)
+}
+
+func appendVarint(data []byte, v int64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	return append(data, b[:binary.PutVarint(b[:], v)]...)
+}
+
+func appendLengthPrefixed(data []byte, b []byte) []byte {
+	return append(appendUvarint(data, uint64(len(b))), b...)
+}
+
+// binaryReader reads the compact binary format, the first error is kept in err
+type binaryReader struct {
+	data []byte
+	err  error
+}
+
+func (r *binaryReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errors.New("invalid binary price")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *binaryReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = errors.New("invalid binary price")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *binaryReader) lengthPrefixed() []byte {
+	n := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.data)) {
+		r.err = errors.New("invalid binary price")
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
@@ -0,0 +1,105 @@
+package price
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		price Price
+		want  string
+	}{
+		// version 1, no flags, precision 64, 12.5 is 25 * 2^-1, EUR packed as 5<<10|21<<5|18 = 5810
+		{price: NewFromInt(125, 10, "EUR"), want: "010040011" + "9b22d"},
+		{price: NewFromInt(-5, 1, "USD"), want: "0101400005e4ac01"},
+		{price: NewFromBigFloat(big.Float{}, ""), want: "010800000000"},
+		{price: NewFromInt(1, 1, "eur"), want: "010840000103657572"},
+		{price: NewFromBigFloat(*new(big.Float).SetInf(true), "EUR"), want: "010300b22d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.price.String(), func(t *testing.T) {
+			data, err := tt.price.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, hex.EncodeToString(data))
+		})
+	}
+}
+
+func TestPrice_UnmarshalBinary(t *testing.T) {
+	huge, ok := new(big.Float).SetPrec(200).SetString("-123456789012345678901234567890.1234567890123456789")
+	require.True(t, ok)
+	for _, original := range []Price{
+		NewFromFloat(12.34, "EUR"),
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-5, 1, "USD"),
+		NewFromFloat(0.1, "JPY"),
+		NewFromBigFloat(*huge, "EUR"),
+		NewFromBigFloat(*new(big.Float).SetPrec(8).SetInt64(1 << 40), "EUR"),
+		NewFromBigFloat(*new(big.Float).SetInf(false), "EUR"),
+		NewFromInt(1, 1, "custom"),
+		NewFromBigFloat(big.Float{}, ""),
+		NewZero(""),
+	} {
+		data, err := original.MarshalBinary()
+		require.NoError(t, err)
+		var decoded Price
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, original.Equal(decoded), "%s decoded as %s", original.amount.Text('p', 0), decoded.amount.Text('p', 0))
+		assert.Equal(t, original.amount.Prec(), decoded.amount.Prec())
+		assert.Equal(t, original.amount.Signbit(), decoded.amount.Signbit())
+	}
+
+	t.Run("legacy JSON", func(t *testing.T) {
+		var p Price
+		require.NoError(t, p.UnmarshalBinary([]byte(`{"amount":"12.34","currency":"EUR"}`)))
+		assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(p))
+	})
+
+	invalid := []string{
+		"", "02", "01", "0100", "010040", "01004001", "0100400119b22dff", "010840000163",
+		// coefficient 25 exceeds precision 4
+		"010004011" + "9b22d",
+		// non-zero coefficient with precision 0
+		"010000011" + "9b22d",
+	}
+	for _, data := range invalid {
+		b, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		var p Price
+		assert.Error(t, p.UnmarshalBinary(b), data)
+	}
+}
+
+func TestPrice_MarshalBinarySize(t *testing.T) {
+	p := NewFromFloat(12.34, "EUR")
+	compact, err := p.MarshalBinary()
+	require.NoError(t, err)
+	text, err := p.MarshalText()
+	require.NoError(t, err)
+	assert.Less(t, len(compact)*2, len(text))
+}
+
+func BenchmarkPrice_GobCart(b *testing.B) {
+	cart := make([]Price, 200)
+	for i := range cart {
+		cart[i] = NewFromInt(int64(i*137), 100, "EUR")
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(cart); err != nil {
+			b.Fatal(err)
+		}
+		var decoded []Price
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
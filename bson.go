@@ -0,0 +1,245 @@
+package price
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// BSON element types used by MarshalBSON, MarshalBSONValue and their counterparts
+const (
+	bsonDouble     byte = 0x01
+	bsonString     byte = 0x02
+	bsonDocument   byte = 0x03
+	bsonUndefined  byte = 0x06
+	bsonNull       byte = 0x0a
+	bsonInt32      byte = 0x10
+	bsonInt64      byte = 0x12
+	bsonDecimal128 byte = 0x13
+)
+
+const (
+	decimal128Digits   = 34
+	decimal128Bias     = 6176
+	decimal128MaxExp   = 6111
+	decimal128MinExp   = -6176
+	decimal128ExpShift = 49
+)
+
+// MarshalBSON implements the bson.Marshaler interface of the MongoDB driver without depending on it.
+// The price is stored as document {amount: Decimal128, currency: string}, so amounts are precise and sortable in MongoDB.
+// Amounts with more than 34 significant digits are rounded half even like Decimal128 does
+func (p Price) MarshalBSON() ([]byte, error) {
+	low, high, err := p.decimal128()
+	if err != nil {
+		return nil, err
+	}
+
+	var elements bytes.Buffer
+	elements.WriteByte(bsonDecimal128)
+	elements.WriteString("amount\x00")
+	_ = binary.Write(&elements, binary.LittleEndian, low)
+	_ = binary.Write(&elements, binary.LittleEndian, high)
+	elements.WriteByte(bsonString)
+	elements.WriteString("currency\x00")
+	_ = binary.Write(&elements, binary.LittleEndian, int32(len(p.currency)+1))
+	elements.WriteString(p.currency)
+	elements.WriteByte(0)
+
+	doc := make([]byte, 4, 4+elements.Len()+1)
+	binary.LittleEndian.PutUint32(doc, uint32(cap(doc)))
+	doc = append(doc, elements.Bytes()...)
+	return append(doc, 0), nil
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface of the MongoDB driver without depending on it.
+// The amount may be a Decimal128, string, double, int32 or int64
+func (p *Price) UnmarshalBSON(data []byte) error {
+	if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data) || data[len(data)-1] != 0 {
+		return errors.New("invalid BSON document")
+	}
+
+	var (
+		amount   *big.Float
+		currency string
+	)
+	rest := data[4 : len(data)-1]
+	for len(rest) > 0 {
+		kind := rest[0]
+		end := bytes.IndexByte(rest[1:], 0)
+		if end < 0 {
+			return errors.New("invalid BSON element name")
+		}
+		name := string(rest[1 : end+1])
+		value := rest[end+2:]
+
+		size, err := bsonValueSize(kind, value)
+		if err != nil {
+			return err
+		}
+		switch name {
+		case "amount":
+			if amount, err = bsonAmount(kind, value[:size]); err != nil {
+				return err
+			}
+		case "currency":
+			if kind != bsonString {
+				return errors.New("currency must be a BSON string")
+			}
+			currency = string(value[4 : size-1])
+		}
+		rest = value[size:]
+	}
+
+	if amount == nil {
+		*p = NewZero(currency)
+		return nil
+	}
+	p.amount = *amount
+	p.currency = currency
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of version 2 of the MongoDB driver without depending on it.
+// The price is an embedded document like MarshalBSON, which version 1 of the driver uses:
+//
+//	type Product struct {
+//		Price price.Price `bson:"price"` // {price: {amount: NumberDecimal("12.34"), currency: "EUR"}}
+//	}
+func (p Price) MarshalBSONValue() (byte, []byte, error) {
+	doc, err := p.MarshalBSON()
+	return bsonDocument, doc, err
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of version 2 of the MongoDB driver.
+// Besides the document of MarshalBSONValue a plain Decimal128, string, double, int32 or int64 amount is accepted
+// and keeps the currency of the receiver, null results in a zero price
+func (p *Price) UnmarshalBSONValue(kind byte, data []byte) error {
+	switch kind {
+	case bsonDocument:
+		return p.UnmarshalBSON(data)
+	case bsonNull, bsonUndefined:
+		*p = NewZero(p.currency)
+		return nil
+	}
+	size, err := bsonValueSize(kind, data)
+	if err != nil {
+		return err
+	}
+	if size != len(data) {
+		return errors.New("unexpected data after BSON value")
+	}
+	amount, err := bsonAmount(kind, data)
+	if err != nil {
+		return err
+	}
+	*p = NewFromBigFloat(*amount, p.currency)
+	return nil
+}
+
+// bsonValueSize returns the size of a BSON value of the supported types
+func bsonValueSize(kind byte, value []byte) (int, error) {
+	size := 0
+	switch kind {
+	case bsonDouble, bsonInt64:
+		size = 8
+	case bsonInt32:
+		size = 4
+	case bsonDecimal128:
+		size = 16
+	case bsonString:
+		if len(value) >= 4 {
+			size = 4 + int(int32(binary.LittleEndian.Uint32(value)))
+		}
+		if size <= 4 || size > len(value) || value[size-1] != 0 {
+			return 0, errors.New("invalid BSON string")
+		}
+	default:
+		return 0, errors.New("unsupported BSON element type")
+	}
+	if size > len(value) {
+		return 0, errors.New("truncated BSON document")
+	}
+	return size, nil
+}
+
+// bsonAmount decodes the amount value of the given BSON type
+func bsonAmount(kind byte, value []byte) (*big.Float, error) {
+	switch kind {
+	case bsonDecimal128:
+		return decimal128Amount(binary.LittleEndian.Uint64(value), binary.LittleEndian.Uint64(value[8:]))
+	case bsonString:
+		amount, _, err := new(big.Float).Parse(string(value[4:len(value)-1]), 10)
+		return amount, err
+	case bsonDouble:
+		amount, err := NewFromFloatChecked(math.Float64frombits(binary.LittleEndian.Uint64(value)), "")
+		return &amount.amount, err
+	case bsonInt32:
+		return new(big.Float).SetInt64(int64(int32(binary.LittleEndian.Uint32(value)))), nil
+	}
+	return new(big.Float).SetInt64(int64(binary.LittleEndian.Uint64(value))), nil
+}
+
+// decimal128 returns the low and high bits of the amount as IEEE 754 decimal128 in binary integer decimal encoding
+func (p Price) decimal128() (low, high uint64, err error) {
+	if p.amount.Sign() < 0 {
+		high = 1 << 63
+	}
+	if p.amount.IsInf() {
+		return 0, high | 0x1e<<58, nil
+	}
+
+	coefficient, exp := p.decimalParts()
+	coefficient.Abs(coefficient)
+	ten := big.NewInt(10)
+
+	if excess := len(coefficient.String()) - decimal128Digits; excess > 0 {
+		scale := new(big.Int).Exp(ten, big.NewInt(int64(excess)), nil)
+		coefficient = roundRat(new(big.Rat).SetFrac(coefficient, scale), RoundingModeHalfEven)
+		exp += excess
+		if len(coefficient.String()) > decimal128Digits {
+			coefficient.Quo(coefficient, ten)
+			exp++
+		}
+	}
+	for exp < decimal128MinExp && coefficient.Sign() != 0 {
+		// below the smallest exponent only the precision is reduced
+		coefficient = roundRat(new(big.Rat).SetFrac(coefficient, ten), RoundingModeHalfEven)
+		exp++
+	}
+	if exp > decimal128MaxExp {
+		return 0, 0, errors.New("amount exceeds the range of Decimal128")
+	}
+
+	mask := new(big.Int).SetUint64(^uint64(0))
+	low = new(big.Int).And(coefficient, mask).Uint64()
+	high |= uint64(exp+decimal128Bias)<<decimal128ExpShift | new(big.Int).Rsh(coefficient, 64).Uint64()
+	return low, high, nil
+}
+
+// decimal128Amount returns the amount of an IEEE 754 decimal128 in binary integer decimal encoding
+func decimal128Amount(low, high uint64) (*big.Float, error) {
+	negative := high>>63 == 1
+	if (high>>61)&3 == 3 {
+		switch (high >> 58) & 0x1f {
+		case 0x1e:
+			return new(big.Float).SetInf(negative), nil
+		case 0x1f:
+			return nil, errors.New("NaN is not a valid amount")
+		}
+		// coefficients of the second form exceed 34 digits, they are non-canonical and represent zero
+		return new(big.Float), nil
+	}
+
+	exp := int((high>>decimal128ExpShift)&0x3fff) - decimal128Bias
+	coefficient := new(big.Int).SetUint64(high & (1<<decimal128ExpShift - 1))
+	coefficient.Lsh(coefficient, 64).Or(coefficient, new(big.Int).SetUint64(low))
+	if negative {
+		coefficient.Neg(coefficient)
+	}
+
+	p, err := newFromDecimalParts(coefficient, exp, "")
+	return &p.amount, err
+}
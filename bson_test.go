@@ -0,0 +1,126 @@
+package price
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bsonDoc builds a BSON document with an amount element of the given type and a currency
+func bsonDoc(kind byte, amount []byte, currency string) []byte {
+	doc := []byte{0, 0, 0, 0, kind}
+	doc = append(doc, "amount\x00"...)
+	doc = append(doc, amount...)
+	doc = append(doc, bsonString)
+	doc = append(doc, "currency\x00"...)
+	doc = appendUint32(doc, uint32(len(currency)+1))
+	doc = append(doc, currency...)
+	doc = append(doc, 0, 0)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+	return doc
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(v)), uint32(v>>32))
+}
+
+func decimal128Bytes(low, high uint64) []byte {
+	return appendUint64(appendUint64(nil, low), high)
+}
+
+func TestPrice_MarshalBSON(t *testing.T) {
+	data, err := NewFromInt(1234, 100, "EUR").MarshalBSON()
+	require.NoError(t, err)
+	assert.Equal(t, bsonDoc(bsonDecimal128, decimal128Bytes(1234, 0x303c000000000000), "EUR"), data)
+
+	data, err = NewFromInt(-1, 1, "EUR").MarshalBSON()
+	require.NoError(t, err)
+	assert.Equal(t, bsonDoc(bsonDecimal128, decimal128Bytes(1, 0xb040000000000000), "EUR"), data)
+
+	third := NewFromBigFloat(*new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3)), "EUR")
+	data, err = third.MarshalBSON()
+	require.NoError(t, err)
+	var decoded Price
+	require.NoError(t, decoded.UnmarshalBSON(data))
+	assert.Equal(t, "0.3333333333333333333333333333333333", decoded.Amount().Text('f', 34))
+}
+
+func TestPrice_UnmarshalBSON(t *testing.T) {
+	for _, p := range []Price{NewFromInt(1234, 100, "EUR"), NewFromInt(-5, 1000, "KWD"), NewZero("JPY"), NewFromInt(12, 1, "")} {
+		data, err := p.MarshalBSON()
+		require.NoError(t, err)
+		var decoded Price
+		require.NoError(t, decoded.UnmarshalBSON(data))
+		assert.True(t, p.Equal(decoded), p.String())
+	}
+
+	inf := NewFromBigFloat(*new(big.Float).SetInf(true), "EUR")
+	data, err := inf.MarshalBSON()
+	require.NoError(t, err)
+	var decoded Price
+	require.NoError(t, decoded.UnmarshalBSON(data))
+	assert.True(t, decoded.Amount().IsInf())
+	assert.True(t, decoded.IsNegative())
+
+	tests := []struct {
+		name string
+		doc  []byte
+	}{
+		{name: "string", doc: bsonDoc(bsonString, append(appendUint32(nil, 6), "12.34\x00"...), "EUR")},
+		{name: "double", doc: bsonDoc(bsonDouble, appendUint64(nil, math.Float64bits(12.34)), "EUR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Price
+			require.NoError(t, p.UnmarshalBSON(tt.doc))
+			assert.True(t, p.LikelyEqual(NewFromInt(1234, 100, "EUR")))
+		})
+	}
+
+	var p Price
+	require.NoError(t, p.UnmarshalBSON(bsonDoc(bsonInt32, appendUint32(nil, 7), "JPY")))
+	assert.True(t, p.Equal(NewFromInt(7, 1, "JPY")))
+
+	assert.Error(t, p.UnmarshalBSON(bsonDoc(bsonDecimal128, decimal128Bytes(0, 0x7c00000000000000), "EUR")), "NaN")
+	assert.Error(t, p.UnmarshalBSON([]byte{5, 0, 0, 0}))
+	assert.Error(t, p.UnmarshalBSON(bsonDoc(0x08, []byte{1}, "EUR")))
+}
+
+func TestPrice_MarshalBSONValue(t *testing.T) {
+	p := NewFromInt(1234, 100, "EUR")
+	kind, data, err := p.MarshalBSONValue()
+	require.NoError(t, err)
+	assert.Equal(t, bsonDocument, kind)
+	assert.Equal(t, bsonDoc(bsonDecimal128, decimal128Bytes(1234, 0x303c000000000000), "EUR"), data)
+
+	var decoded Price
+	require.NoError(t, decoded.UnmarshalBSONValue(kind, data))
+	assert.True(t, p.Equal(decoded))
+
+	// the signatures of the value interfaces of the MongoDB driver v2
+	var _ interface{ MarshalBSONValue() (byte, []byte, error) } = p
+	var _ interface{ UnmarshalBSONValue(byte, []byte) error } = &decoded
+}
+
+func TestPrice_UnmarshalBSONValue(t *testing.T) {
+	decoded := NewZero("EUR")
+	require.NoError(t, decoded.UnmarshalBSONValue(bsonDecimal128, decimal128Bytes(1234, 0x303c000000000000)))
+	assert.True(t, decoded.Equal(NewFromInt(1234, 100, "EUR")), "plain amounts keep the currency")
+
+	require.NoError(t, decoded.UnmarshalBSONValue(bsonString, append(appendUint32(nil, 5), "9.99\x00"...)))
+	assert.True(t, decoded.LikelyEqual(NewFromInt(999, 100, "EUR")))
+
+	require.NoError(t, decoded.UnmarshalBSONValue(bsonNull, nil))
+	assert.True(t, decoded.Equal(NewZero("EUR")))
+
+	assert.Error(t, decoded.UnmarshalBSONValue(bsonInt32, appendUint64(nil, 7)))
+	assert.Error(t, decoded.UnmarshalBSONValue(0x08, []byte{1}))
+}
@@ -0,0 +1,102 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+var (
+	cashIncrementsMu sync.RWMutex
+	// cashIncrements holds the smallest cash increment per currency (in upper case)
+	cashIncrements = map[string]*big.Rat{
+		"AUD": big.NewRat(5, 100),
+		"CAD": big.NewRat(5, 100),
+		"CHF": big.NewRat(5, 100),
+		"DKK": big.NewRat(50, 100),
+		"NZD": big.NewRat(10, 100),
+		"SEK": big.NewRat(1, 1),
+	}
+)
+
+// SetCashRoundingIncrement configures the smallest cash increment of a currency, e.g. 0.05 for CHF.
+// A zero, negative or infinite increment removes the configuration.
+func SetCashRoundingIncrement(currency string, increment Price) {
+	cashIncrementsMu.Lock()
+	defer cashIncrementsMu.Unlock()
+
+	key := strings.ToUpper(currency)
+	if !increment.IsPositive() || increment.amount.IsInf() {
+		delete(cashIncrements, key)
+		return
+	}
+	inc, _ := increment.amount.Rat(nil)
+	cashIncrements[key] = inc
+}
+
+// CashRoundingIncrement returns the smallest cash increment of the currency, the second return value is false
+// if cash payments of the currency are not rounded to a special increment
+func CashRoundingIncrement(currency string) (Price, bool) {
+	increment, ok := cashIncrement(currency)
+	if !ok {
+		return NewZero(currency), false
+	}
+	return NewFromBigFloat(*new(big.Float).SetRat(increment), currency), true
+}
+
+// GetPayableCash rounds the price to the cash increment configured for its currency (e.g. 5 cents for CHF)
+// using RoundingModeHalfUp. Prices of currencies without a cash increment are rounded with GetPayable.
+func (p Price) GetPayableCash() Price {
+	return p.GetPayableCashByRoundingMode(RoundingModeHalfUp)
+}
+
+// GetPayableCashByRoundingMode rounds the price to the cash increment configured for its currency using the given mode.
+// The price is rounded with GetPayable first, so that the cash rounding is based on the payable amount.
+// Prices of currencies without a cash increment are rounded with GetPayable.
+func (p Price) GetPayableCashByRoundingMode(mode RoundingMode) Price {
+	payable := p.GetPayable()
+	increment, ok := cashIncrement(p.currency)
+	if !ok {
+		return payable
+	}
+	return payable.roundToIncrement(increment, mode)
+}
+
+// SnapToGrid rounds the price with mode to a multiple of step, e.g. to the nearest 0.25 or 10 in price lists
+func (p Price) SnapToGrid(step Price, mode RoundingMode) (Price, error) {
+	if step.currency != p.currency {
+		return Price{}, ErrCurrencyMismatch
+	}
+	if step.amount.IsInf() {
+		return Price{}, newDetailedError(ErrNotFinite, "step must be a finite amount higher than zero")
+	}
+	if !step.IsPositive() {
+		return Price{}, errors.New("step must be a finite amount higher than zero")
+	}
+	if err := mode.Validate(); err != nil {
+		return Price{}, err
+	}
+	if p.amount.IsInf() {
+		return Price{}, newDetailedError(ErrNotFinite, "cannot snap infinite amounts")
+	}
+	increment, _ := step.amount.Rat(nil)
+	return p.roundToIncrement(increment, mode), nil
+}
+
+func cashIncrement(currency string) (*big.Rat, bool) {
+	cashIncrementsMu.RLock()
+	defer cashIncrementsMu.RUnlock()
+	increment, ok := cashIncrements[strings.ToUpper(currency)]
+	return increment, ok
+}
+
+// roundToIncrement rounds the price to a multiple of the increment
+func (p Price) roundToIncrement(increment *big.Rat, mode RoundingMode) Price {
+	if p.amount.IsInf() || increment.Sign() <= 0 {
+		return p.Clone()
+	}
+	amount, _ := p.amount.Rat(nil)
+	steps := roundRat(amount.Quo(amount, increment), mode)
+	return p.withRat(new(big.Rat).Mul(new(big.Rat).SetInt(steps), increment))
+}
@@ -0,0 +1,97 @@
+package price
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_GetPayableCash(t *testing.T) {
+	tests := []struct {
+		price    float64
+		currency string
+		expected string
+	}{
+		{price: 12.32, currency: "CHF", expected: "12.30"},
+		{price: 12.325, currency: "CHF", expected: "12.35"},
+		{price: 12.375, currency: "CHF", expected: "12.40"},
+		{price: -12.33, currency: "CHF", expected: "-12.35"},
+		{price: 12.34, currency: "NZD", expected: "12.30"},
+		{price: 12.24, currency: "DKK", expected: "12.00"},
+		{price: 12.25, currency: "DKK", expected: "12.50"},
+		{price: 12.345, currency: "EUR", expected: "12.35"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%f %s", tt.price, tt.currency), func(t *testing.T) {
+			payable := NewFromFloat(tt.price, tt.currency).GetPayableCash()
+			assert.Equal(t, tt.expected, payable.Amount().Text('f', 2))
+			assert.Equal(t, tt.currency, payable.Currency())
+		})
+	}
+}
+
+func TestPrice_GetPayableCashByRoundingMode(t *testing.T) {
+	price := NewFromFloat(12.31, "CHF")
+	assert.Equal(t, "12.35", price.GetPayableCashByRoundingMode(RoundingModeCeil).Amount().Text('f', 2))
+	assert.Equal(t, "12.30", price.GetPayableCashByRoundingMode(RoundingModeFloor).Amount().Text('f', 2))
+}
+
+func TestSetCashRoundingIncrement(t *testing.T) {
+	SetCashRoundingIncrement("XTS", NewFromInt(25, 100, "XTS"))
+	defer SetCashRoundingIncrement("XTS", NewZero("XTS"))
+
+	increment, ok := CashRoundingIncrement("xts")
+	assert.True(t, ok)
+	assert.Equal(t, "0.25", increment.Amount().Text('f', 2))
+
+	assert.Equal(t, "10.25", NewFromFloat(10.3, "XTS").GetPayableCash().Amount().Text('f', 2))
+	assert.Equal(t, "10.50", NewFromFloat(10.4, "XTS").GetPayableCash().Amount().Text('f', 2))
+
+	SetCashRoundingIncrement("XTS", NewZero("XTS"))
+	_, ok = CashRoundingIncrement("XTS")
+	assert.False(t, ok)
+
+	SetCashRoundingIncrement("XTS", NewFromInt(25, 100, "XTS"))
+	SetCashRoundingIncrement("XTS", NewFromBigFloat(*new(big.Float).SetInf(false), "XTS"))
+	_, ok = CashRoundingIncrement("XTS")
+	assert.False(t, ok)
+	assert.Equal(t, "10.30", NewFromFloat(10.3, "XTS").GetPayableCash().Amount().Text('f', 2))
+}
+
+func TestPrice_SnapToGrid(t *testing.T) {
+	tests := []struct {
+		name  string
+		price Price
+		step  Price
+		mode  RoundingMode
+		want  string
+	}{
+		{name: "quarter half up", price: NewFromInt(1213, 100, "EUR"), step: NewFromInt(25, 100, "EUR"), mode: RoundingModeHalfUp, want: "12.25"},
+		{name: "quarter floor", price: NewFromInt(1224, 100, "EUR"), step: NewFromInt(25, 100, "EUR"), mode: RoundingModeFloor, want: "12"},
+		{name: "tens ceil", price: NewFromInt(1201, 100, "EUR"), step: NewFromInt(10, 1, "EUR"), mode: RoundingModeCeil, want: "20"},
+		{name: "fuel tenth of a cent", price: NewFromInt(17894, 10000, "EUR"), step: NewFromInt(1, 1000, "EUR"), mode: RoundingModeHalfUp, want: "1.789"},
+		{name: "half even", price: NewFromInt(15, 1, "EUR"), step: NewFromInt(10, 1, "EUR"), mode: RoundingModeHalfEven, want: "20"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.price.SnapToGrid(tt.step, tt.mode)
+			require.NoError(t, err)
+			f, _ := result.Amount().Float64()
+			assert.Equal(t, tt.want, strconv.FormatFloat(f, 'f', -1, 64))
+		})
+	}
+
+	_, err := NewFromInt(1, 1, "EUR").SnapToGrid(NewFromInt(1, 1, "USD"), RoundingModeHalfUp)
+	assert.Error(t, err)
+
+	_, err = NewFromInt(1, 1, "EUR").SnapToGrid(NewZero("EUR"), RoundingModeHalfUp)
+	assert.Error(t, err)
+
+	_, err = NewFromInt(1, 1, "EUR").SnapToGrid(NewFromInt(1, 1, "EUR"), RoundingMode("unknown"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,263 @@
+package price
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// CBOR major types and tags used by MarshalCBOR and UnmarshalCBOR, see RFC 8949
+const (
+	cborUnsigned byte = 0
+	cborNegative byte = 1
+	cborBytes    byte = 2
+	cborText     byte = 3
+	cborArray    byte = 4
+	cborMap      byte = 5
+	cborTag      byte = 6
+	cborSimple   byte = 7
+
+	cborTagPositiveBignum = 2
+	cborTagNegativeBignum = 3
+	cborTagDecimal        = 4
+)
+
+// MarshalCBOR implements the cbor.Marshaler interface of fxamacker/cbor without depending on it.
+// The price is encoded as map {"amount": decimal fraction, "currency": text}, the amount uses the
+// decimal fraction tag 4 of RFC 8949, e.g. 12.34 is 4([-2, 1234])
+func (p Price) MarshalCBOR() ([]byte, error) {
+	if p.amount.IsInf() {
+		return nil, ErrNotFinite
+	}
+	coefficient, exp := p.decimalParts()
+
+	data := cborHead(nil, cborMap, 2)
+	data = cborString(data, "amount")
+	data = cborHead(data, cborTag, cborTagDecimal)
+	data = cborHead(data, cborArray, 2)
+	data = cborInt(data, big.NewInt(int64(exp)))
+	data = cborInt(data, coefficient)
+	data = cborString(data, "currency")
+	return cborString(data, p.currency), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface of fxamacker/cbor without depending on it.
+// Besides decimal fractions the amount may be an integer, a float or a decimal text
+func (p *Price) UnmarshalCBOR(data []byte) error {
+	r := &cborReader{data: data}
+	major, pairs, err := r.head()
+	if err != nil {
+		return err
+	}
+	if major != cborMap {
+		return errors.New("price must be a CBOR map")
+	}
+
+	result := NewZero("")
+	var currency string
+	for i := uint64(0); i < pairs; i++ {
+		key, err := r.text()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "amount":
+			if result, err = r.amount(); err != nil {
+				return err
+			}
+		case "currency":
+			if currency, err = r.text(); err != nil {
+				return err
+			}
+		default:
+			return errors.New("unknown CBOR price field " + key)
+		}
+	}
+	if r.pos != len(data) {
+		return errors.New("unexpected data after CBOR price")
+	}
+
+	result.currency = currency
+	*p = result
+	return nil
+}
+
+// cborHead appends the initial byte(s) of a data item with the major type and argument
+func cborHead(data []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(data, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(data, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return append(data, major|25, byte(n>>8), byte(n))
+	case n <= math.MaxUint32:
+		return append(data, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], n)
+	return append(append(data, major|27), b[:]...)
+}
+
+func cborString(data []byte, s string) []byte {
+	return append(cborHead(data, cborText, uint64(len(s))), s...)
+}
+
+// cborInt appends an integer, integers beyond 64 bits are encoded as bignum
+func cborInt(data []byte, n *big.Int) []byte {
+	major, tag := cborUnsigned, uint64(cborTagPositiveBignum)
+	value := new(big.Int).Set(n)
+	if n.Sign() < 0 {
+		// negative integers are encoded as -1 - n
+		major, tag = cborNegative, cborTagNegativeBignum
+		value.Neg(value).Sub(value, big.NewInt(1))
+	}
+	if value.IsUint64() {
+		return cborHead(data, major, value.Uint64())
+	}
+	bytes := value.Bytes()
+	return append(cborHead(cborHead(data, cborTag, tag), cborBytes, uint64(len(bytes))), bytes...)
+}
+
+// cborReader reads the CBOR data items of a price, indefinite lengths are not supported
+type cborReader struct {
+	data []byte
+	pos  int
+	// info is the additional information of the last head, it tells the size of floats
+	info byte
+}
+
+func (r *cborReader) head() (byte, uint64, error) {
+	if r.pos >= len(r.data) {
+		return 0, 0, errors.New("unexpected end of CBOR data")
+	}
+	major, info := r.data[r.pos]>>5, r.data[r.pos]&0x1f
+	r.pos++
+	r.info = info
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, errors.New("unsupported CBOR length")
+	}
+	size := 1 << (info - 24)
+	if r.pos+size > len(r.data) {
+		return 0, 0, errors.New("unexpected end of CBOR data")
+	}
+	var n uint64
+	for _, b := range r.data[r.pos : r.pos+size] {
+		n = n<<8 | uint64(b)
+	}
+	r.pos += size
+	return major, n, nil
+}
+
+func (r *cborReader) bytes(n uint64) ([]byte, error) {
+	if n > uint64(len(r.data)-r.pos) {
+		return nil, errors.New("unexpected end of CBOR data")
+	}
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *cborReader) text() (string, error) {
+	major, n, err := r.head()
+	if err != nil {
+		return "", err
+	}
+	if major != cborText {
+		return "", errors.New("expected CBOR text")
+	}
+	b, err := r.bytes(n)
+	return string(b), err
+}
+
+// integer reads an integer or bignum
+func (r *cborReader) integer() (*big.Int, error) {
+	major, n, err := r.head()
+	if err != nil {
+		return nil, err
+	}
+	return r.integerOf(major, n)
+}
+
+func (r *cborReader) integerOf(major byte, n uint64) (*big.Int, error) {
+	switch {
+	case major == cborUnsigned:
+		return new(big.Int).SetUint64(n), nil
+	case major == cborNegative:
+		return new(big.Int).Sub(big.NewInt(-1), new(big.Int).SetUint64(n)), nil
+	case major == cborTag && (n == cborTagPositiveBignum || n == cborTagNegativeBignum):
+		bytesMajor, size, err := r.head()
+		if err != nil {
+			return nil, err
+		}
+		if bytesMajor != cborBytes {
+			return nil, errors.New("CBOR bignum must be a byte string")
+		}
+		b, err := r.bytes(size)
+		if err != nil {
+			return nil, err
+		}
+		value := new(big.Int).SetBytes(b)
+		if n == cborTagNegativeBignum {
+			value.Sub(big.NewInt(-1), value)
+		}
+		return value, nil
+	}
+	return nil, errors.New("expected CBOR integer")
+}
+
+// amount reads a decimal fraction, integer, float or decimal text
+func (r *cborReader) amount() (Price, error) {
+	major, n, err := r.head()
+	if err != nil {
+		return Price{}, err
+	}
+
+	switch {
+	case major == cborTag && n == cborTagDecimal:
+		if major, n, err = r.head(); err != nil {
+			return Price{}, err
+		}
+		if major != cborArray || n != 2 {
+			return Price{}, errors.New("CBOR decimal fraction must be an array of exponent and mantissa")
+		}
+		exp, err := r.integer()
+		if err != nil {
+			return Price{}, err
+		}
+		if !exp.IsInt64() || exp.Int64() < math.MinInt32 || exp.Int64() > math.MaxInt32 {
+			return Price{}, errors.New("CBOR decimal exponent out of range")
+		}
+		mantissa, err := r.integer()
+		if err != nil {
+			return Price{}, err
+		}
+		return newFromDecimalParts(mantissa, int(exp.Int64()), "")
+	case major == cborText:
+		b, err := r.bytes(n)
+		if err != nil {
+			return Price{}, err
+		}
+		amount, _, err := new(big.Float).Parse(string(b), 10)
+		if err != nil {
+			return Price{}, errors.New("invalid amount " + string(b))
+		}
+		return NewFromBigFloat(*amount, ""), nil
+	case major == cborSimple && r.info == 26:
+		// the argument holds the bits of a float32
+		return NewFromFloatChecked(float64(math.Float32frombits(uint32(n))), "")
+	case major == cborSimple && r.info == 27:
+		return NewFromFloatChecked(math.Float64frombits(n), "")
+	}
+
+	integer, err := r.integerOf(major, n)
+	if err != nil {
+		return Price{}, errors.New("unsupported CBOR amount")
+	}
+	return NewFromBigFloat(*new(big.Float).SetInt(integer), ""), nil
+}
@@ -0,0 +1,142 @@
+package price
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_MarshalCBOR(t *testing.T) {
+	tests := []struct {
+		name  string
+		price Price
+		want  string
+	}{
+		{
+			// {"amount": 4([-2, 1234]), "currency": "EUR"}
+			name:  "decimal fraction",
+			price: NewFromInt(1234, 100, "EUR"),
+			want:  "a266616d6f756e74c482211904d26863757272656e637963455552",
+		},
+		{
+			name:  "negative integer",
+			price: NewFromInt(-5, 1, "EUR"),
+			want:  "a266616d6f756e74c48200246863757272656e637963455552",
+		},
+		{
+			name:  "zero",
+			price: NewZero("USD"),
+			want:  "a266616d6f756e74c48200006863757272656e637963555344",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.price.MarshalCBOR()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, hex.EncodeToString(data))
+		})
+	}
+
+	t.Run("infinite amount", func(t *testing.T) {
+		_, err := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR").MarshalCBOR()
+		assert.ErrorIs(t, err, ErrNotFinite)
+	})
+}
+
+func TestPrice_UnmarshalCBOR(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Price
+	}{
+		{
+			name: "decimal fraction",
+			data: "a266616d6f756e74c482211904d26863757272656e637963455552",
+			want: NewFromInt(1234, 100, "EUR"),
+		},
+		{
+			name: "integer",
+			data: "a266616d6f756e741864" + "6863757272656e637963455552",
+			want: NewFromInt(100, 1, "EUR"),
+		},
+		{
+			name: "text",
+			data: "a266616d6f756e746531322e33346863757272656e637963455552",
+			want: NewFromInt(1234, 100, "EUR"),
+		},
+		{
+			name: "float64",
+			data: "a266616d6f756e74fb3ff80000000000006863757272656e637963455552",
+			want: NewFromInt(15, 10, "EUR"),
+		},
+		{
+			name: "float32",
+			data: "a266616d6f756e74fa3fc000006863757272656e637963455552",
+			want: NewFromInt(15, 10, "EUR"),
+		},
+		{
+			name: "negative bignum mantissa",
+			data: "a266616d6f756e74c48221c349010000000000000000" + "6863757272656e637963455552",
+			want: cborTestPrice(t, "-18446744073709551617", -2, "EUR"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+			var p Price
+			require.NoError(t, p.UnmarshalCBOR(data))
+			assert.True(t, tt.want.LikelyEqual(p), "got %s", p.String())
+			assert.Equal(t, tt.want.Currency(), p.Currency())
+		})
+	}
+
+	invalid := []string{
+		"",
+		"80",
+		"a266616d6f756e74c4",
+		"a265707269636501",
+		"a166616d6f756e74f97e00",
+		"a166616d6f756e74fb7ff0000000000000",
+		"a266616d6f756e74016863757272656e637963455552ff",
+	}
+	for _, data := range invalid {
+		b, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		var p Price
+		assert.Error(t, p.UnmarshalCBOR(b), data)
+	}
+}
+
+func TestPrice_CBORRoundTrip(t *testing.T) {
+	huge, ok := new(big.Float).SetPrec(128).SetString("-123456789012345678901234567890.125")
+	require.True(t, ok)
+	prices := []Price{
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-1, 1000, "JPY"),
+		NewFromFloat(0.1, "USD"),
+		NewFromFloat(math.MaxInt64, "USD"),
+		NewFromBigFloat(*huge, "EUR"),
+		NewZero(""),
+	}
+	for _, p := range prices {
+		data, err := p.MarshalCBOR()
+		require.NoError(t, err)
+		var decoded Price
+		require.NoError(t, decoded.UnmarshalCBOR(data))
+		assert.Equal(t, p.canonicalAmount(), decoded.canonicalAmount())
+		assert.Equal(t, p.Currency(), decoded.Currency())
+	}
+}
+
+func cborTestPrice(t *testing.T, coefficient string, exp int, currency string) Price {
+	c, ok := new(big.Int).SetString(coefficient, 10)
+	require.True(t, ok)
+	p, err := newFromDecimalParts(c, exp, currency)
+	require.NoError(t, err)
+	return p
+}
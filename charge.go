@@ -1,6 +1,14 @@
 package price
 
-import "errors"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+)
 
 const (
 	// ChargeTypeGiftCard  used as a charge type for gift cards
@@ -21,6 +29,8 @@ type (
 		Type string
 		// Reference contains further information to distinguish charges of the same type
 		Reference string
+		// Metadata holds optional additional information (e.g. the gift card provider), it is not part of the ChargeQualifier
+		Metadata map[string]string
 	}
 
 	// Charges - Represents the Charges the product need to be paid with
@@ -37,8 +47,42 @@ type (
 		// Reference contains further information to distinguish charges of the same type
 		Reference string
 	}
+
+	// chargeJSON is the wire format of a single charge within Charges
+	chargeJSON struct {
+		Type      string            `json:"type"`
+		Reference string            `json:"reference,omitempty"`
+		Price     Price             `json:"price"`
+		Value     Price             `json:"value"`
+		Metadata  map[string]string `json:"metadata,omitempty"`
+	}
+
+	// Converter converts a Price into the base currency used for Charge values
+	Converter interface {
+		ToBase(p Price) (Price, error)
+	}
 )
 
+// NewCharge creates a Charge of the given type and reference paid with p.
+// The Value is computed by the converter so that Price and Value always belong together.
+// A nil converter means p is already in the base currency.
+func NewCharge(typ, ref string, p Price, conv Converter) (Charge, error) {
+	value := p.Clone()
+	if conv != nil {
+		var err error
+		value, err = conv.ToBase(p)
+		if err != nil {
+			return Charge{}, err
+		}
+	}
+	return Charge{
+		Price:     p,
+		Value:     value,
+		Type:      typ,
+		Reference: ref,
+	}, nil
+}
+
 // Add the given Charge to the current Charge and returns a new Charge
 func (p Charge) Add(add Charge) (Charge, error) {
 	if p.Type != add.Type {
@@ -58,6 +102,25 @@ func (p Charge) Add(add Charge) (Charge, error) {
 	return p, nil
 }
 
+// Sub the given Charge from the current Charge and returns a new Charge
+func (p Charge) Sub(sub Charge) (Charge, error) {
+	if p.Type != sub.Type {
+		return Charge{}, errors.New("charge type mismatch")
+	}
+	newPrice, err := p.Price.Sub(sub.Price)
+	if err != nil {
+		return Charge{}, err
+	}
+	p.Price = newPrice
+
+	newPrice, err = p.Value.Sub(sub.Value)
+	if err != nil {
+		return Charge{}, err
+	}
+	p.Value = newPrice
+	return p, nil
+}
+
 // GetPayable rounds the charge
 func (p Charge) GetPayable() Charge {
 	p.Value = p.Value.GetPayable()
@@ -72,12 +135,103 @@ func (p Charge) Mul(qty int) Charge {
 	return p
 }
 
+// WithConvertedValue returns a new Charge with the Value derived from the Price converted into the base currency
+func (p Charge) WithConvertedValue(converter CurrencyConverter, base string) (Charge, error) {
+	value, err := BaseConverter(converter, base).ToBase(p.Price)
+	if err != nil {
+		return Charge{}, err
+	}
+	p.Value = value
+	return p, nil
+}
+
+// SplitInPayables splits the charge in "count" payable charges, Price and Value are split consistently.
+// see Price.SplitInPayables
+func (p Charge) SplitInPayables(count int) ([]Charge, error) {
+	prices, err := p.Price.SplitInPayables(count)
+	if err != nil {
+		return nil, err
+	}
+	values, err := p.Value.SplitInPayables(count)
+	if err != nil {
+		return nil, err
+	}
+
+	charges := make([]Charge, count)
+	for i := 0; i < count; i++ {
+		charges[i] = p
+		charges[i].Price = prices[i]
+		charges[i].Value = values[i]
+	}
+	return charges, nil
+}
+
 // NewCharges creates a new Charges object
 func NewCharges(chargesByType map[string]Charge) *Charges {
 	charges := addChargeQualifier(chargesByType)
 	return &charges
 }
 
+// String returns the canonical string form "type:reference" of the qualifier, e.g. "giftcard:ABC123".
+// The reference is left out if it is empty, colons and percent signs within the type are escaped
+func (q ChargeQualifier) String() string {
+	escapedType := qualifierTypeEscaper.Replace(q.Type)
+	if q.Reference == "" {
+		return escapedType
+	}
+	return escapedType + ":" + q.Reference
+}
+
+// ParseChargeQualifier parses the canonical string form of a qualifier, see ChargeQualifier.String
+func ParseChargeQualifier(s string) (ChargeQualifier, error) {
+	escapedType, reference, _ := strings.Cut(s, ":")
+	if escapedType == "" {
+		return ChargeQualifier{}, errors.New("charge qualifier without type: " + s)
+	}
+	chargeType, err := url.PathUnescape(escapedType)
+	if err != nil {
+		return ChargeQualifier{}, err
+	}
+	return ChargeQualifier{Type: chargeType, Reference: reference}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, so that qualifiers can be used as JSON map keys
+func (q ChargeQualifier) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (q *ChargeQualifier) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargeQualifier(string(text))
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// MarshalJSON keeps the object form {"Type":"giftcard","Reference":"ABC123"} of qualifier values,
+// only map keys use the string form of MarshalText
+func (q ChargeQualifier) MarshalJSON() ([]byte, error) {
+	type qualifierJSON ChargeQualifier
+	return json.Marshal(qualifierJSON(q))
+}
+
+// UnmarshalJSON decodes the object form of MarshalJSON, the string form of MarshalText is accepted as well
+func (q *ChargeQualifier) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return q.UnmarshalText([]byte(text))
+	}
+	type qualifierJSON ChargeQualifier
+	return json.Unmarshal(data, (*qualifierJSON)(q))
+}
+
+var qualifierTypeEscaper = strings.NewReplacer("%", "%25", ":", "%3A")
+
 // HasType returns a true if any charges include a charge with given type
 func (c Charges) HasType(ctype string) bool {
 	for qualifier := range c.chargesByQualifier {
@@ -96,14 +250,22 @@ func (c Charges) GetByType(ctype string) (Charge, bool) {
 	if !c.HasType(ctype) {
 		return Charge{}, false
 	}
-	result := Charge{
-		Type: ctype,
-	}
-	// sum up all charges with certain type to one charge
+	// sum up all charges with certain type to one charge, the sum starts with the first charge
+	// so that it has a currency also with SetStrictCurrencyGuard
+	var (
+		result Charge
+		found  bool
+	)
 	for qualifier, charge := range c.chargesByQualifier {
-		if qualifier.Type == ctype {
-			result, _ = result.Add(charge)
+		if qualifier.Type != ctype {
+			continue
 		}
+		if !found {
+			result, found = charge, true
+			result.Reference = ""
+			continue
+		}
+		result, _ = result.Add(charge)
 	}
 	return result, true
 }
@@ -152,9 +314,9 @@ func (c Charges) GetByTypeForced(ctype string) Charge {
 	return result
 }
 
-// GetAllCharges returns all charges
+// GetAllCharges returns all charges, the returned map is a copy
 func (c Charges) GetAllCharges() map[ChargeQualifier]Charge {
-	return c.chargesByQualifier
+	return c.clone().chargesByQualifier
 }
 
 // GetAllByType returns all charges of type
@@ -173,63 +335,296 @@ func (c Charges) GetAllByType(ctype string) map[ChargeQualifier]Charge {
 	return chargesByType
 }
 
-// Add returns new Charges with the given added
+// Add returns new Charges with the given added, the receiver is not modified
 func (c Charges) Add(toadd Charges) Charges {
-	if c.chargesByQualifier == nil {
-		c.chargesByQualifier = make(map[ChargeQualifier]Charge)
-	}
+	c = c.clone()
+	hooks := currentChargesHooks()
 	for addk, addCharge := range toadd.chargesByQualifier {
 		if existingCharge, ok := c.chargesByQualifier[addk]; ok {
 			chargeSum, _ := existingCharge.Add(addCharge)
 			c.chargesByQualifier[addk] = chargeSum.GetPayable()
+			hooks.merged(addk, existingCharge, c.chargesByQualifier[addk])
 		} else {
 			c.chargesByQualifier[addk] = addCharge
+			hooks.added(addk, addCharge)
 		}
 	}
 	return c
 }
 
-// AddCharge returns new Charges with the given Charge added
+// AddCharge returns new Charges with the given Charge added, the receiver is not modified
 func (c Charges) AddCharge(toadd Charge) Charges {
-	if c.chargesByQualifier == nil {
-		c.chargesByQualifier = make(map[ChargeQualifier]Charge)
-	}
+	c = c.clone()
 	qualifier := ChargeQualifier{
 		Type:      toadd.Type,
 		Reference: toadd.Reference,
 	}
+	hooks := currentChargesHooks()
 	if existingCharge, ok := c.chargesByQualifier[qualifier]; ok {
 		chargeSum, _ := existingCharge.Add(toadd)
 		c.chargesByQualifier[qualifier] = chargeSum.GetPayable()
+		hooks.merged(qualifier, existingCharge, c.chargesByQualifier[qualifier])
 	} else {
 		c.chargesByQualifier[qualifier] = toadd
+		hooks.added(qualifier, toadd)
 	}
 
 	return c
 }
 
-// Mul returns new Charges with the given multiplied
+// Cap returns new Charges where the price of the charge with the given qualifier is reduced to max.
+// The value of the charge is reduced by the same ratio. Charges below max are not changed, max must not be negative.
+func (c Charges) Cap(qualifier ChargeQualifier, max Price) (Charges, error) {
+	existingCharge, ok := c.chargesByQualifier[qualifier]
+	if !ok {
+		return c, nil
+	}
+	if _, err := existingCharge.Price.currencyGuard(max); err != nil {
+		return c, err
+	}
+	if max.IsNegative() {
+		return c, errors.New("cap must not be negative")
+	}
+	if existingCharge.Price.amount.Cmp(&max.amount) <= 0 {
+		return c, nil
+	}
+
+	ratio, _ := new(big.Float).Quo(max.Amount(), existingCharge.Price.Amount()).Rat(nil)
+	if ratio == nil {
+		return c, newDetailedError(ErrNotFinite, "cap ratio must be a finite number")
+	}
+	capped := existingCharge
+	capped.Price = NewFromBigFloat(*new(big.Float).Set(&max.amount), existingCharge.Price.currency)
+	capped.Value = existingCharge.Value.mulRat(ratio).GetPayable()
+	c = c.clone()
+	c.chargesByQualifier[qualifier] = capped
+	currentChargesHooks().capped(qualifier, existingCharge, capped)
+
+	return c, nil
+}
+
+// Sub returns new Charges with the given subtracted, the receiver is not modified.
+// Charges which are zero afterwards are removed, charges not contained in the receiver are added inverted.
+func (c Charges) Sub(tosub Charges) (Charges, error) {
+	c = c.clone()
+	for subk, subCharge := range tosub.chargesByQualifier {
+		existingCharge, ok := c.chargesByQualifier[subk]
+		if !ok {
+			// zero prices in the currencies of the subtrahend, so that the strict currency guard accepts them
+			existingCharge = Charge{
+				Type:      subk.Type,
+				Reference: subk.Reference,
+				Price:     NewZero(subCharge.Price.Currency()),
+				Value:     NewZero(subCharge.Value.Currency()),
+			}
+		}
+		diff, err := existingCharge.Sub(subCharge)
+		if err != nil {
+			return Charges{}, err
+		}
+		if diff.Price.IsZero() && diff.Value.IsZero() {
+			delete(c.chargesByQualifier, subk)
+			continue
+		}
+		c.chargesByQualifier[subk] = diff
+	}
+	return c, nil
+}
+
+// RemoveCharge returns new Charges without the charge of the given qualifier, the receiver is not modified
+func (c Charges) RemoveCharge(qualifier ChargeQualifier) Charges {
+	c = c.clone()
+	delete(c.chargesByQualifier, qualifier)
+	return c
+}
+
+// RemoveByType returns new Charges without any charge of the given type, the receiver is not modified
+func (c Charges) RemoveByType(ctype string) Charges {
+	c = c.clone()
+	for qualifier := range c.chargesByQualifier {
+		if qualifier.Type == ctype {
+			delete(c.chargesByQualifier, qualifier)
+		}
+	}
+	return c
+}
+
+// Filter returns new Charges containing only the charges for which keep returns true
+func (c Charges) Filter(keep func(ChargeQualifier, Charge) bool) Charges {
+	filtered := make(map[ChargeQualifier]Charge)
+	for qualifier, charge := range c.chargesByQualifier {
+		if keep(qualifier, charge) {
+			filtered[qualifier] = charge
+		}
+	}
+	return Charges{chargesByQualifier: filtered}
+}
+
+// Map returns new Charges with every charge replaced by the result of f, the qualifier of each charge is kept
+func (c Charges) Map(f func(Charge) Charge) Charges {
+	mapped := make(map[ChargeQualifier]Charge, len(c.chargesByQualifier))
+	for qualifier, charge := range c.chargesByQualifier {
+		mapped[qualifier] = f(charge)
+	}
+	return Charges{chargesByQualifier: mapped}
+}
+
+// Mul returns new Charges with the given multiplied, the receiver is not modified
 func (c Charges) Mul(qty int) Charges {
 	if c.chargesByQualifier == nil {
 		return c
 	}
+	c = c.clone()
 	for t, charge := range c.chargesByQualifier {
 		c.chargesByQualifier[t] = charge.Mul(qty)
 	}
 	return c
 }
 
-// Items returns all charges items
+// Items returns all charges items ordered by type and reference
 func (c Charges) Items() []Charge {
 	var charges []Charge
 
-	for _, charge := range c.chargesByQualifier {
-		charges = append(charges, charge)
+	for _, qualifier := range c.sortedQualifiers() {
+		charges = append(charges, c.chargesByQualifier[qualifier])
 	}
 
 	return charges
 }
 
+// OrderedQualifiers returns the qualifiers of all charges ordered by type and reference
+func (c Charges) OrderedQualifiers() []ChargeQualifier {
+	return c.sortedQualifiers()
+}
+
+// CanonicalBytes returns a stable encoding of all charges, e.g. to sign a payment breakdown with HMAC.
+// Charges are sorted by type and reference and amounts are encoded with their exact binary value, so equal charges
+// always result in the same bytes regardless of map iteration order and the precision of the amounts.
+func (c Charges) CanonicalBytes() ([]byte, error) {
+	type canonicalPrice struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}
+	type canonicalCharge struct {
+		Type      string         `json:"type"`
+		Reference string         `json:"reference"`
+		Price     canonicalPrice `json:"price"`
+		Value     canonicalPrice `json:"value"`
+	}
+
+	qualifiers := c.sortedQualifiers()
+	canonical := make([]canonicalCharge, 0, len(qualifiers))
+	for _, qualifier := range qualifiers {
+		charge := c.chargesByQualifier[qualifier]
+		canonical = append(canonical, canonicalCharge{
+			Type:      qualifier.Type,
+			Reference: qualifier.Reference,
+			Price:     canonicalPrice{Amount: charge.Price.exactAmount(), Currency: charge.Price.currency},
+			Value:     canonicalPrice{Amount: charge.Value.exactAmount(), Currency: charge.Value.currency},
+		})
+	}
+
+	return json.Marshal(canonical)
+}
+
+// MarshalJSON encodes the charges as array of {type, reference, price, value} sorted by type and reference
+func (c Charges) MarshalJSON() ([]byte, error) {
+	qualifiers := c.sortedQualifiers()
+	charges := make([]chargeJSON, 0, len(qualifiers))
+	for _, qualifier := range qualifiers {
+		charge := c.chargesByQualifier[qualifier]
+		charges = append(charges, chargeJSON{
+			Type:      qualifier.Type,
+			Reference: qualifier.Reference,
+			Price:     charge.Price,
+			Value:     charge.Value,
+			Metadata:  charge.Metadata,
+		})
+	}
+	return json.Marshal(charges)
+}
+
+// UnmarshalJSON decodes charges encoded by MarshalJSON, charges with the same type and reference are summed up
+func (c *Charges) UnmarshalJSON(data []byte) error {
+	var charges []chargeJSON
+	if err := json.Unmarshal(data, &charges); err != nil {
+		return err
+	}
+
+	chargesByQualifier := make(map[ChargeQualifier]Charge, len(charges))
+	for _, cj := range charges {
+		qualifier := ChargeQualifier{Type: cj.Type, Reference: cj.Reference}
+		charge := Charge{Type: cj.Type, Reference: cj.Reference, Price: cj.Price, Value: cj.Value, Metadata: cj.Metadata}
+		if existingCharge, ok := chargesByQualifier[qualifier]; ok {
+			sum, err := existingCharge.Add(charge)
+			if err != nil {
+				return err
+			}
+			charge = sum
+		}
+		chargesByQualifier[qualifier] = charge
+	}
+	c.chargesByQualifier = chargesByQualifier
+	return nil
+}
+
+// MarshalBinary implements interface required by gob
+func (c Charges) MarshalBinary() (data []byte, err error) {
+	return c.MarshalJSON()
+}
+
+// UnmarshalBinary implements interface required by gob.
+// Modifies the receiver so it must take a pointer receiver!
+func (c *Charges) UnmarshalBinary(data []byte) error {
+	return c.UnmarshalJSON(data)
+}
+
+// Value makes the Charges struct implement the driver.Valuer interface. This method
+// simply returns the JSON-encoded representation of the charges.
+func (c Charges) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan makes the Charges struct implement the sql.Scanner interface. This method
+// simply decodes a JSON-encoded value into the charges, like Price.Scan it accepts []byte and string.
+// NULL results in empty charges
+func (c *Charges) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*c = Charges{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	}
+	return errors.New("unsupported type for charges")
+}
+
+// clone returns Charges with a copy of the underlying map, so that modifications do not affect the receiver
+func (c Charges) clone() Charges {
+	chargesByQualifier := make(map[ChargeQualifier]Charge, len(c.chargesByQualifier))
+	for qualifier, charge := range c.chargesByQualifier {
+		chargesByQualifier[qualifier] = charge
+	}
+	return Charges{chargesByQualifier: chargesByQualifier}
+}
+
+// sortedQualifiers returns the qualifiers of all charges sorted by type and reference
+func (c Charges) sortedQualifiers() []ChargeQualifier {
+	qualifiers := make([]ChargeQualifier, 0, len(c.chargesByQualifier))
+	for qualifier := range c.chargesByQualifier {
+		qualifiers = append(qualifiers, qualifier)
+	}
+	sort.Slice(qualifiers, func(i, j int) bool {
+		if qualifiers[i].Type != qualifiers[j].Type {
+			return qualifiers[i].Type < qualifiers[j].Type
+		}
+		return qualifiers[i].Reference < qualifiers[j].Reference
+	})
+	return qualifiers
+}
+
 // addChargeQualifier parse string keys to charge qualifier for backwards compatibility
 func addChargeQualifier(chargesByType map[string]Charge) Charges {
 	withQualifier := make(map[ChargeQualifier]Charge)
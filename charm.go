@@ -0,0 +1,34 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// RoundToEnding rounds the payable price up to the next price ending with the given minor units (charm pricing),
+// e.g. ending 99 turns 12.30 EUR into 12.99 EUR and ending 95 turns 12.96 EUR into 13.95 EUR.
+// The number of digits of ending defines the step, so ending 980 for JPY rounds to the next X980.
+// Prices already having the ending are returned as payable price.
+func (p Price) RoundToEnding(ending int64) (Price, error) {
+	if ending < 0 {
+		return Price{}, errors.New("ending must not be negative")
+	}
+	if p.IsNegative() {
+		return Price{}, errors.New("cannot apply charm pricing to negative prices")
+	}
+	if p.amount.IsInf() {
+		return Price{}, newDetailedError(ErrNotFinite, "cannot apply charm pricing to infinite amounts")
+	}
+
+	units, precision := p.payableUnits()
+	step := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(strconv.FormatInt(ending, 10)))), nil)
+	end := big.NewInt(ending)
+
+	// next is the lowest price with the ending not below units
+	k := new(big.Int).Sub(units, end)
+	k.Add(k, step).Sub(k, big.NewInt(1))
+	k.Div(k, step)
+	next := new(big.Int).Add(new(big.Int).Mul(k, step), end)
+	return p.withRat(new(big.Rat).SetFrac(next, big.NewInt(int64(precision)))), nil
+}
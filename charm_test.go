@@ -0,0 +1,41 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_RoundToEnding(t *testing.T) {
+	tests := []struct {
+		name   string
+		price  Price
+		ending int64
+		want   string
+	}{
+		{name: "up to .99", price: NewFromInt(1230, 100, "EUR"), ending: 99, want: "12.99"},
+		{name: "next unit", price: NewFromInt(1310, 100, "EUR"), ending: 99, want: "13.99"},
+		{name: "already charm", price: NewFromInt(1299, 100, "EUR"), ending: 99, want: "12.99"},
+		{name: "to .95", price: NewFromInt(1230, 100, "EUR"), ending: 95, want: "12.95"},
+		{name: "above ending", price: NewFromInt(1296, 100, "EUR"), ending: 95, want: "13.95"},
+		{name: "unpayable input", price: NewFromFloat(12.994, "EUR"), ending: 99, want: "12.99"},
+		{name: "zero", price: NewZero("EUR"), ending: 99, want: "0.99"},
+		{name: "zero decimal currency", price: NewFromInt(12345, 1, "JPY"), ending: 980, want: "12980"},
+		{name: "single digit", price: NewFromInt(1234, 100, "EUR"), ending: 9, want: "12.39"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.price.RoundToEnding(tt.ending)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want+" "+tt.price.Currency(), result.Display())
+			assert.Equal(t, tt.price.Currency(), result.Currency())
+		})
+	}
+
+	_, err := NewFromInt(10, 1, "EUR").RoundToEnding(-1)
+	assert.Error(t, err)
+
+	_, err = NewFromInt(-10, 1, "EUR").RoundToEnding(99)
+	assert.Error(t, err)
+}
@@ -0,0 +1,247 @@
+package price
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// JSONAmountMode defines how the amount of a price is written to JSON
+type JSONAmountMode string
+
+const (
+	// JSONAmountString writes the amount as string, e.g. {"amount":"55.11"} (default)
+	JSONAmountString JSONAmountMode = "string"
+	// JSONAmountNumber writes the exact amount as JSON number, e.g. {"amount":55.11}.
+	// Be aware that many JSON decoders read numbers as float64
+	JSONAmountNumber JSONAmountMode = "number"
+)
+
+var (
+	// jsonAmountMode holds the JSONAmountMode used by Price.MarshalJSON
+	jsonAmountMode atomic.Value
+
+	jsonCodecsMu sync.RWMutex
+	// jsonCodecs holds the codecs registered with RegisterJSONCodec
+	jsonCodecs = map[string]JSONCodec{}
+)
+
+// SetJSONAmountMode sets the mode used by Price.MarshalJSON for all prices, pass "" to restore JSONAmountString.
+// Use a JSONCodec to choose the mode per marshal
+func SetJSONAmountMode(mode JSONAmountMode) {
+	if mode == "" {
+		mode = JSONAmountString
+	}
+	jsonAmountMode.Store(mode)
+}
+
+func currentJSONAmountMode() JSONAmountMode {
+	mode, _ := jsonAmountMode.Load().(JSONAmountMode)
+	return mode
+}
+
+// JSONCodec marshals and unmarshals a Price using configurable field names.
+// Use it if a consumer requires another wire format than the default one of Price.MarshalJSON,
+// e.g. {"value":"12.34","currencyCode":"EUR"}.
+// The zero value behaves like Price.MarshalJSON.
+type JSONCodec struct {
+	// AmountField is the name of the amount field, defaults to "amount"
+	AmountField string
+	// CurrencyField is the name of the currency field, defaults to "currency"
+	CurrencyField string
+	// OmitZeroAmount leaves out the amount field if the price is zero
+	OmitZeroAmount bool
+	// KeepEmptyCurrency writes the currency field even if the currency is empty
+	KeepEmptyCurrency bool
+	// AmountMode defines if the amount is written as string (default) or number
+	AmountMode JSONAmountMode
+	// MinorUnits encodes the amount as integer in the smallest unit of the currency, e.g. {"amount":1234,"currency":"EUR"} for 12.34 EUR.
+	// The amount is rounded to the payable amount, see Price.MinorUnits and NewFromMinorUnits
+	MinorUnits bool
+	// FractionDigitsField is the name of a field holding the decimal digits of an integer amount,
+	// e.g. "fractionDigits" for {"value":1234,"currencyCode":"EUR","fractionDigits":2}. If set the amount is encoded like MinorUnits
+	// together with the decimal digits of the minor unit, when decoding the amount is divided by 10^fractionDigits
+	FractionDigitsField string
+}
+
+// Marshal returns the JSON encoding of p
+func (c JSONCodec) Marshal(p Price) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	written := false
+	writeField := func(name string, value []byte) error {
+		if written {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+		written = true
+		return nil
+	}
+
+	if !c.OmitZeroAmount || !p.IsZero() {
+		amount, err := c.marshalAmount(p)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeField(c.amountField(), amount); err != nil {
+			return nil, err
+		}
+	}
+	if c.KeepEmptyCurrency || p.currency != "" {
+		currency, err := json.Marshal(p.currency)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeField(c.currencyField(), currency); err != nil {
+			return nil, err
+		}
+	}
+	if c.FractionDigitsField != "" {
+		digits, ok := fractionDigits(payablePrecision(p.currency))
+		if !ok {
+			return nil, errors.New("payable precision of " + p.currency + " has no fraction digits")
+		}
+		if err := writeField(c.FractionDigitsField, []byte(strconv.Itoa(digits))); err != nil {
+			return nil, err
+		}
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the JSON encoded data into p, a missing amount results in a zero price
+func (c JSONCodec) Unmarshal(data []byte, p *Price) error {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var amount, currency string
+	if raw, ok := fields[c.amountField()]; ok {
+		var err error
+		if amount, err = unmarshalJSONAmount(raw); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields[c.currencyField()]; ok {
+		if err := json.Unmarshal(raw, &currency); err != nil {
+			return err
+		}
+	}
+
+	if amount == "" {
+		*p = NewZero(currency)
+		return nil
+	}
+	if raw, ok := fields[c.FractionDigitsField]; ok && c.FractionDigitsField != "" {
+		var digits int
+		if err := json.Unmarshal(raw, &digits); err != nil || digits < 0 || digits > 18 {
+			return errors.New("fraction digits must be a number between 0 and 18")
+		}
+		units, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return errors.New("invalid minor units " + amount)
+		}
+		*p = NewFromInt(units, CurrencyInfo{MinorUnits: digits}.Precision(), currency)
+		return nil
+	}
+	if c.MinorUnits || c.FractionDigitsField != "" {
+		units, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return errors.New("invalid minor units " + amount)
+		}
+		*p = NewFromMinorUnits(units, currency)
+		return nil
+	}
+
+	am, _, err := new(big.Float).Parse(amount, 10)
+	if err != nil {
+		return errors.New("invalid amount " + amount)
+	}
+
+	p.amount = *am
+	p.currency = currency
+	return nil
+}
+
+// marshalAmount returns the JSON value of the amount for the AmountMode
+func (c JSONCodec) marshalAmount(p Price) ([]byte, error) {
+	var amount string
+	switch {
+	case c.MinorUnits, c.FractionDigitsField != "":
+		units, err := p.MinorUnits()
+		if err != nil {
+			return nil, err
+		}
+		amount = strconv.FormatInt(units, 10)
+	case c.AmountMode == JSONAmountNumber:
+		if p.amount.IsInf() {
+			return nil, ErrNotFinite
+		}
+		amount = p.canonicalAmount()
+	default:
+		amount = p.amount.String()
+	}
+
+	switch c.AmountMode {
+	case "", JSONAmountString:
+		return json.Marshal(amount)
+	case JSONAmountNumber:
+		return []byte(amount), nil
+	}
+	return nil, errors.New("unknown JSON amount mode " + string(c.AmountMode))
+}
+
+// unmarshalJSONAmount returns the amount of a JSON string or number
+func unmarshalJSONAmount(raw json.RawMessage) (string, error) {
+	var amount string
+	if err := json.Unmarshal(raw, &amount); err == nil {
+		return amount, nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return "", errors.New("amount must be a string or number")
+	}
+	return number.String(), nil
+}
+
+// RegisterJSONCodec registers a codec by name, e.g. for the format of a feed. An existing codec is replaced
+func RegisterJSONCodec(name string, codec JSONCodec) {
+	jsonCodecsMu.Lock()
+	defer jsonCodecsMu.Unlock()
+	jsonCodecs[name] = codec
+}
+
+// LookupJSONCodec returns the codec registered with the name, the second return value is false if there is none
+func LookupJSONCodec(name string) (JSONCodec, bool) {
+	jsonCodecsMu.RLock()
+	defer jsonCodecsMu.RUnlock()
+	codec, ok := jsonCodecs[name]
+	return codec, ok
+}
+
+func (c JSONCodec) amountField() string {
+	if c.AmountField == "" {
+		return "amount"
+	}
+	return c.AmountField
+}
+
+func (c JSONCodec) currencyField() string {
+	if c.CurrencyField == "" {
+		return "currency"
+	}
+	return c.CurrencyField
+}
@@ -0,0 +1,178 @@
+package price
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONCodec_Marshal(t *testing.T) {
+	t.Run("zero value codec matches MarshalJSON", func(t *testing.T) {
+		price := NewFromFloat(55.111111, "USD")
+
+		data, err := JSONCodec{}.Marshal(price)
+		require.NoError(t, err)
+		expected, err := price.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(data))
+	})
+
+	t.Run("custom field names", func(t *testing.T) {
+		codec := JSONCodec{AmountField: "value", CurrencyField: "currencyCode"}
+
+		data, err := codec.Marshal(NewFromInt(1234, 100, "EUR"))
+		require.NoError(t, err)
+		assert.Equal(t, `{"value":"12.34","currencyCode":"EUR"}`, string(data))
+	})
+
+	t.Run("omit zero amount", func(t *testing.T) {
+		codec := JSONCodec{OmitZeroAmount: true}
+
+		data, err := codec.Marshal(NewZero("EUR"))
+		require.NoError(t, err)
+		assert.Equal(t, `{"currency":"EUR"}`, string(data))
+
+		data, err = JSONCodec{KeepEmptyCurrency: true}.Marshal(NewZero(""))
+		require.NoError(t, err)
+		assert.Equal(t, `{"amount":"0","currency":""}`, string(data))
+	})
+}
+
+func TestJSONCodec_Unmarshal(t *testing.T) {
+	codec := JSONCodec{AmountField: "value", CurrencyField: "currencyCode"}
+
+	var p Price
+	require.NoError(t, codec.Unmarshal([]byte(`{"value":"12.34","currencyCode":"EUR"}`), &p))
+	assert.True(t, p.LikelyEqual(NewFromFloat(12.34, "EUR")))
+	assert.Equal(t, "EUR", p.Currency())
+
+	require.NoError(t, codec.Unmarshal([]byte(`{"currencyCode":"USD"}`), &p))
+	assert.True(t, p.IsZero())
+	assert.Equal(t, "USD", p.Currency())
+
+	assert.Error(t, codec.Unmarshal([]byte(`{"value":"abc"}`), &p))
+	assert.Error(t, codec.Unmarshal([]byte(`[]`), &p))
+}
+
+func TestJSONCodec_AmountMode(t *testing.T) {
+	codec := JSONCodec{AmountMode: JSONAmountNumber}
+
+	data, err := codec.Marshal(NewFromFloat(55.11, "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":55.11,"currency":"USD"}`, string(data))
+
+	var p Price
+	require.NoError(t, codec.Unmarshal(data, &p))
+	assert.True(t, p.LikelyEqual(NewFromFloat(55.11, "USD")))
+
+	_, err = codec.Marshal(NewFromBigFloat(*new(big.Float).SetInf(false), "USD"))
+	assert.Error(t, err)
+	_, err = JSONCodec{AmountMode: "hex"}.Marshal(NewZero("USD"))
+	assert.Error(t, err)
+}
+
+func TestSetJSONAmountMode(t *testing.T) {
+	SetJSONAmountMode(JSONAmountNumber)
+	t.Cleanup(func() { SetJSONAmountMode("") })
+
+	data, err := json.Marshal(struct {
+		Total Price `json:"total"`
+	}{Total: NewFromInt(1234, 100, "EUR")})
+	require.NoError(t, err)
+	assert.Equal(t, `{"total":{"amount":12.34,"currency":"EUR"}}`, string(data))
+
+	value, err := NewFromInt(1234, 100, "EUR").Value()
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"12.34","currency":"EUR"}`, string(value.([]byte)), "database values keep the string form")
+
+	SetJSONAmountMode("")
+	data, err = json.Marshal(NewFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"12.34","currency":"EUR"}`, string(data))
+}
+
+func TestJSONCodec_MinorUnits(t *testing.T) {
+	codec := JSONCodec{MinorUnits: true, AmountMode: JSONAmountNumber}
+
+	data, err := codec.Marshal(NewFromFloat(12.345, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":1235,"currency":"EUR"}`, string(data))
+
+	var p Price
+	require.NoError(t, codec.Unmarshal([]byte(`{"amount":1234,"currency":"EUR"}`), &p))
+	assert.True(t, p.Equal(NewFromMinorUnits(1234, "EUR")))
+	require.NoError(t, codec.Unmarshal([]byte(`{"amount":"1234","currency":"JPY"}`), &p))
+	assert.True(t, p.Equal(NewFromMinorUnits(1234, "JPY")))
+	assert.Error(t, codec.Unmarshal([]byte(`{"amount":12.34,"currency":"EUR"}`), &p))
+
+	data, err = JSONCodec{MinorUnits: true}.Marshal(NewFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"1234","currency":"EUR"}`, string(data))
+}
+
+func TestPrice_UnmarshalJSON_Tolerant(t *testing.T) {
+	tests := []string{
+		`{"amount":"55.11","currency":"USD"}`,
+		`{"amount":55.11,"currency":"USD"}`,
+		`{"amount":5.511e1,"currency":"USD"}`,
+	}
+	for _, data := range tests {
+		var p Price
+		require.NoError(t, json.Unmarshal([]byte(data), &p), data)
+		assert.True(t, p.LikelyEqual(NewFromFloat(55.11, "USD")), data)
+	}
+
+	var p Price
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":true,"currency":"USD"}`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"abc","currency":"USD"}`), &p))
+}
+
+func TestJSONCodec_FractionDigits(t *testing.T) {
+	codec := JSONCodec{
+		AmountField:         "value",
+		CurrencyField:       "currencyCode",
+		FractionDigitsField: "fractionDigits",
+		AmountMode:          JSONAmountNumber,
+	}
+
+	var p Price
+	require.NoError(t, codec.Unmarshal([]byte(`{"value":1234,"currencyCode":"EUR","fractionDigits":2}`), &p))
+	assert.True(t, p.Equal(NewFromInt(1234, 100, "EUR")))
+	require.NoError(t, codec.Unmarshal([]byte(`{"value":1234,"currencyCode":"KWD","fractionDigits":3}`), &p))
+	assert.True(t, p.Equal(NewFromInt(1234, 1000, "KWD")))
+	require.NoError(t, codec.Unmarshal([]byte(`{"value":1234,"currencyCode":"JPY"}`), &p), "missing digits use the minor units of the currency")
+	assert.True(t, p.Equal(NewFromMinorUnits(1234, "JPY")))
+
+	assert.Error(t, codec.Unmarshal([]byte(`{"value":1234,"currencyCode":"EUR","fractionDigits":-1}`), &p))
+	assert.Error(t, codec.Unmarshal([]byte(`{"value":12.34,"currencyCode":"EUR","fractionDigits":2}`), &p))
+
+	data, err := codec.Marshal(NewFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"value":1234,"currencyCode":"EUR","fractionDigits":2}`, string(data))
+
+	data, err = codec.Marshal(NewFromFloat(99.9, "points"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"value":99,"currencyCode":"points","fractionDigits":0}`, string(data))
+	require.NoError(t, codec.Unmarshal(data, &p))
+	assert.Equal(t, "99", p.Amount().Text('f', -1))
+}
+
+func TestRegisterJSONCodec(t *testing.T) {
+	_, ok := LookupJSONCodec("legacy-feed")
+	assert.False(t, ok)
+
+	codec := JSONCodec{AmountField: "value", FractionDigitsField: "fractionDigits"}
+	RegisterJSONCodec("legacy-feed", codec)
+	t.Cleanup(func() {
+		jsonCodecsMu.Lock()
+		delete(jsonCodecs, "legacy-feed")
+		jsonCodecsMu.Unlock()
+	})
+
+	registered, ok := LookupJSONCodec("legacy-feed")
+	require.True(t, ok)
+	assert.Equal(t, codec, registered)
+}
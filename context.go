@@ -0,0 +1,160 @@
+package price
+
+import "errors"
+
+// Context fixes currency, rounding mode and precision for a sequence of operations.
+// Every result of a Context operation is rounded with the same policy, so that rounded and unrounded
+// intermediate results are never mixed (e.g. during invoice generation).
+type Context struct {
+	currency  string
+	mode      RoundingMode
+	precision int
+}
+
+// NewContext creates a Context for the given currency, rounding mode (e.g. RoundingModeHalfUp) and precision (e.g. 100 for cents)
+func NewContext(currency string, mode RoundingMode, precision int) (Context, error) {
+	if precision <= 0 {
+		return Context{}, errors.New("precision must be higher than zero")
+	}
+	if err := mode.Validate(); err != nil {
+		return Context{}, err
+	}
+	return Context{
+		currency:  currency,
+		mode:      mode,
+		precision: precision,
+	}, nil
+}
+
+// NewPayableContext creates a Context using the payable rounding of the currency, see Price.GetPayable
+func NewPayableContext(currency string) Context {
+	mode, precision := NewZero(currency).payableRoundingPrecision()
+	return Context{
+		currency:  currency,
+		mode:      mode,
+		precision: precision,
+	}
+}
+
+// Currency returns the currency of the context
+func (c Context) Currency() string {
+	return c.currency
+}
+
+// RoundingMode returns the rounding mode of the context
+func (c Context) RoundingMode() RoundingMode {
+	return c.mode
+}
+
+// Precision returns the rounding precision of the context
+func (c Context) Precision() int {
+	return c.precision
+}
+
+// NewFromFloat creates a rounded price in the currency of the context
+func (c Context) NewFromFloat(amount float64) Price {
+	return c.round(NewFromFloat(amount, c.currency))
+}
+
+// NewFromInt creates a rounded price in the currency of the context, see price.NewFromInt
+func (c Context) NewFromInt(amount int64, precision int) Price {
+	return c.round(NewFromInt(amount, precision, c.currency))
+}
+
+// Round rounds the given price with the policy of the context
+func (c Context) Round(p Price) (Price, error) {
+	if err := c.guard(p); err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(p), nil
+}
+
+// Add returns the rounded sum of a and b
+func (c Context) Add(a, b Price) (Price, error) {
+	if err := c.guard(a, b); err != nil {
+		return NewZero(c.currency), err
+	}
+	sum, err := a.Add(b)
+	if err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(sum), nil
+}
+
+// Sub returns the rounded difference of a and b
+func (c Context) Sub(a, b Price) (Price, error) {
+	if err := c.guard(a, b); err != nil {
+		return NewZero(c.currency), err
+	}
+	diff, err := a.Sub(b)
+	if err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(diff), nil
+}
+
+// Sum returns the rounded sum of all given prices, an empty list results in zero
+func (c Context) Sum(prices ...Price) (Price, error) {
+	if err := c.guard(prices...); err != nil {
+		return NewZero(c.currency), err
+	}
+	result := NewZero(c.currency)
+	for _, p := range prices {
+		result = c.round(result.ForceAdd(p))
+	}
+	return result, nil
+}
+
+// Multiply returns the rounded price multiplied by qty
+func (c Context) Multiply(p Price, qty int) (Price, error) {
+	if err := c.guard(p); err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(p.Multiply(qty)), nil
+}
+
+// Discount returns the rounded price reduced by the given percent
+func (c Context) Discount(p Price, percent Percent) (Price, error) {
+	if err := c.guard(p); err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(p.DiscountedBy(percent)), nil
+}
+
+// Tax returns the rounded tax amount of the given net price
+func (c Context) Tax(net Price, percent Percent) (Price, error) {
+	if err := c.guard(net); err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.round(net.TaxFromNetBy(percent)), nil
+}
+
+// Taxed returns the rounded gross price of the given net price, the tax amount is rounded before it is added
+func (c Context) Taxed(net Price, percent Percent) (Price, error) {
+	tax, err := c.Tax(net, percent)
+	if err != nil {
+		return NewZero(c.currency), err
+	}
+	return c.Add(net, tax)
+}
+
+// round applies the rounding policy and sets the currency of the context
+func (c Context) round(p Price) Price {
+	rounded := p.GetPayableByRoundingMode(c.mode, c.precision)
+	rounded.currency = c.currency
+	return rounded
+}
+
+// guard makes sure all prices are in the currency of the context, zero prices without currency are accepted
+func (c Context) guard(prices ...Price) error {
+	for _, p := range prices {
+		if p.currency == c.currency {
+			continue
+		}
+		if p.currency == "" && p.IsZero() {
+			continue
+		}
+		return newDetailedError(ErrCurrencyMismatch, "price currency "+p.currency+" does not match context currency "+c.currency)
+	}
+	return nil
+}
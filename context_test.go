@@ -0,0 +1,72 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewContext(t *testing.T) {
+	_, err := NewContext("EUR", RoundingModeHalfUp, 0)
+	assert.Error(t, err)
+
+	_, err = NewContext("EUR", "unknown", 100)
+	assert.Error(t, err)
+
+	ctx, err := NewContext("EUR", RoundingModeFloor, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", ctx.Currency())
+	assert.Equal(t, RoundingModeFloor, ctx.RoundingMode())
+	assert.Equal(t, 100, ctx.Precision())
+
+	ctx = NewPayableContext("points")
+	assert.Equal(t, RoundingModeFloor, ctx.RoundingMode())
+	assert.Equal(t, 1, ctx.Precision())
+}
+
+func TestContext_Operations(t *testing.T) {
+	ctx := NewPayableContext("EUR")
+
+	sum, err := ctx.Add(NewFromFloat(1.005, "EUR"), NewFromFloat(2.001, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, sum.Equal(NewFromInt(301, 100, "EUR")))
+
+	diff, err := ctx.Sub(NewFromInt(1000, 100, "EUR"), NewFromFloat(0.333, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, diff.Equal(NewFromInt(967, 100, "EUR")))
+
+	total, err := ctx.Sum(NewFromFloat(0.333, "EUR"), NewFromFloat(0.333, "EUR"), NewFromFloat(0.333, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, total.Equal(NewFromInt(99, 100, "EUR")))
+
+	row, err := ctx.Multiply(NewFromFloat(0.333, "EUR"), 3)
+	require.NoError(t, err)
+	assert.True(t, row.Equal(NewFromInt(100, 100, "EUR")))
+
+	discounted, err := ctx.Discount(NewFromInt(1245, 100, "EUR"), NewPercent(10))
+	require.NoError(t, err)
+	assert.True(t, discounted.Equal(NewFromInt(1121, 100, "EUR")))
+
+	tax, err := ctx.Tax(NewFromInt(999, 100, "EUR"), NewPercent(19))
+	require.NoError(t, err)
+	assert.True(t, tax.Equal(NewFromInt(190, 100, "EUR")))
+
+	gross, err := ctx.Taxed(NewFromInt(999, 100, "EUR"), NewPercent(19))
+	require.NoError(t, err)
+	assert.True(t, gross.Equal(NewFromInt(1189, 100, "EUR")))
+}
+
+func TestContext_CurrencyGuard(t *testing.T) {
+	ctx := NewPayableContext("EUR")
+
+	_, err := ctx.Add(NewFromInt(1, 1, "EUR"), NewFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+
+	_, err = ctx.Round(NewFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+
+	sum, err := ctx.Add(NewFromInt(1, 1, "EUR"), Price{})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", sum.Currency())
+}
@@ -0,0 +1,108 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+)
+
+type (
+	// CurrencyConverter converts prices between currencies
+	CurrencyConverter interface {
+		Convert(p Price, to string) (Price, error)
+	}
+
+	// baseConverter converts to a fixed base currency using a CurrencyConverter
+	baseConverter struct {
+		converter CurrencyConverter
+		base      string
+	}
+)
+
+// BaseConverter returns a Converter (e.g. for NewCharge) converting prices into the base currency with the given converter
+func BaseConverter(converter CurrencyConverter, base string) Converter {
+	return baseConverter{converter: converter, base: base}
+}
+
+// ToBase converts p into the base currency, prices already in the base currency are returned as is
+func (b baseConverter) ToBase(p Price) (Price, error) {
+	if p.Currency() == b.base {
+		return p, nil
+	}
+	return b.converter.Convert(p, b.base)
+}
+
+// RateTable is a CurrencyConverter using fixed exchange rates relative to a base currency.
+// The zero value is not usable, use NewRateTable.
+type RateTable struct {
+	mu    sync.RWMutex
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewRateTable returns an empty rate table for the given base currency
+func NewRateTable(base string) *RateTable {
+	return &RateTable{
+		base:  base,
+		rates: map[string]*big.Rat{},
+	}
+}
+
+// Base returns the base currency of the table
+func (t *RateTable) Base() string {
+	return t.base
+}
+
+// SetRate sets the rate of currency, which is the amount of currency for one unit of the base currency
+func (t *RateTable) SetRate(currency string, rate big.Float) error {
+	if rate.Sign() <= 0 || rate.IsInf() {
+		return errors.New("rate must be a finite number higher than zero")
+	}
+	if currency == t.base {
+		return errors.New("rate of the base currency is always 1")
+	}
+	r, _ := rate.Rat(nil)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rates[currency] = r
+	return nil
+}
+
+// Rate returns the rate of currency relative to the base currency
+func (t *RateTable) Rate(currency string) (big.Float, bool) {
+	r, ok := t.rate(currency)
+	if !ok {
+		return big.Float{}, false
+	}
+	return *new(big.Float).SetPrec(64).SetRat(r), true
+}
+
+// Convert converts p into the currency to, the result is not rounded
+func (t *RateTable) Convert(p Price, to string) (Price, error) {
+	if p.Currency() == to {
+		return p, nil
+	}
+	fromRate, ok := t.rate(p.Currency())
+	if !ok {
+		return Price{}, errors.New("no exchange rate for " + p.Currency())
+	}
+	toRate, ok := t.rate(to)
+	if !ok {
+		return Price{}, errors.New("no exchange rate for " + to)
+	}
+
+	converted := p.mulRat(new(big.Rat).Quo(toRate, fromRate))
+	converted.currency = to
+	return converted, nil
+}
+
+func (t *RateTable) rate(currency string) (*big.Rat, bool) {
+	if currency == t.base {
+		return big.NewRat(1, 1), true
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	r, ok := t.rates[currency]
+	return r, ok
+}
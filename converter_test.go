@@ -0,0 +1,77 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateTable_Convert(t *testing.T) {
+	table := NewRateTable("EUR")
+	require.NoError(t, table.SetRate("USD", *big.NewFloat(1.25)))
+	require.NoError(t, table.SetRate("CHF", *big.NewFloat(0.5)))
+
+	t.Run("from base", func(t *testing.T) {
+		converted, err := table.Convert(NewFromInt(10, 1, "EUR"), "USD")
+		require.NoError(t, err)
+		assert.Equal(t, "USD", converted.Currency())
+		assert.Equal(t, "12.5", converted.Amount().Text('f', -1))
+	})
+
+	t.Run("to base", func(t *testing.T) {
+		converted, err := table.Convert(NewFromInt(25, 1, "USD"), "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, "20", converted.Amount().Text('f', -1))
+	})
+
+	t.Run("cross rate", func(t *testing.T) {
+		converted, err := table.Convert(NewFromInt(5, 1, "CHF"), "USD")
+		require.NoError(t, err)
+		assert.Equal(t, "USD", converted.Currency())
+		assert.Equal(t, "12.5", converted.Amount().Text('f', -1))
+	})
+
+	t.Run("same currency", func(t *testing.T) {
+		converted, err := table.Convert(NewFromInt(5, 1, "GBP"), "GBP")
+		require.NoError(t, err)
+		assert.True(t, converted.Equal(NewFromInt(5, 1, "GBP")))
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		_, err := table.Convert(NewFromInt(5, 1, "GBP"), "EUR")
+		assert.Error(t, err)
+		_, err = table.Convert(NewFromInt(5, 1, "EUR"), "GBP")
+		assert.Error(t, err)
+	})
+}
+
+func TestRateTable_SetRate(t *testing.T) {
+	table := NewRateTable("EUR")
+	assert.Error(t, table.SetRate("USD", *big.NewFloat(0)))
+	assert.Error(t, table.SetRate("USD", *big.NewFloat(-1)))
+	assert.Error(t, table.SetRate("EUR", *big.NewFloat(2)))
+
+	_, ok := table.Rate("USD")
+	assert.False(t, ok)
+
+	require.NoError(t, table.SetRate("USD", *big.NewFloat(1.25)))
+	rate, ok := table.Rate("USD")
+	assert.True(t, ok)
+	assert.Equal(t, "1.25", rate.Text('f', -1))
+
+	rate, ok = table.Rate("EUR")
+	assert.True(t, ok)
+	assert.Equal(t, "1", rate.Text('f', -1))
+}
+
+func TestRateTable_BaseConverter(t *testing.T) {
+	table := NewRateTable("EUR")
+	require.NoError(t, table.SetRate("USD", *big.NewFloat(2)))
+
+	charge, err := NewCharge(ChargeTypeMain, "", NewFromInt(10, 1, "USD"), BaseConverter(table, table.Base()))
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", charge.Value.Currency())
+	assert.Equal(t, "5", charge.Value.Amount().Text('f', -1))
+}
@@ -0,0 +1,86 @@
+package price
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CouponScope defines what a coupon is applied to
+type CouponScope string
+
+const (
+	// CouponScopeItem applies the coupon to single items
+	CouponScopeItem CouponScope = "item"
+	// CouponScopeCart applies the coupon to the cart total
+	CouponScopeCart CouponScope = "cart"
+	// CouponScopeShipping applies the coupon to the shipping costs
+	CouponScopeShipping CouponScope = "shipping"
+)
+
+// Coupon is a discount redeemable with a code.
+// A UsageLimit of 0 means the coupon can be used without limit, UsageCount is the number of times it was used.
+type Coupon struct {
+	Code       string      `db:"code" firestore:"code" json:"code"`
+	Discount   Discount    `db:"discount" firestore:"discount" json:"discount"`
+	Scope      CouponScope `db:"scope" firestore:"scope" json:"scope"`
+	UsageLimit int         `db:"usage_limit,omitempty" firestore:"usageLimit,omitempty" json:"usageLimit,omitempty"`
+	UsageCount int         `db:"usage_count,omitempty" firestore:"usageCount,omitempty" json:"usageCount,omitempty"`
+}
+
+// Validate returns an error if the coupon has no code, an unknown scope or invalid usage numbers
+func (c Coupon) Validate() error {
+	if c.Code == "" {
+		return errors.New("coupon needs a code")
+	}
+	switch c.Scope {
+	case CouponScopeItem, CouponScopeCart, CouponScopeShipping:
+	default:
+		return errors.New("unknown coupon scope " + string(c.Scope))
+	}
+	if c.UsageLimit < 0 || c.UsageCount < 0 {
+		return errors.New("coupon usage must not be negative")
+	}
+	return nil
+}
+
+// IsExhausted returns true if the usage limit is reached
+func (c Coupon) IsExhausted() bool {
+	return c.UsageLimit > 0 && c.UsageCount >= c.UsageLimit
+}
+
+// IsRedeemable returns true if the coupon is not exhausted and its discount is active at the given time
+func (c Coupon) IsRedeemable(at time.Time) bool {
+	return !c.IsExhausted() && c.Discount.IsActive(at)
+}
+
+// Redeem applies the discount to p and returns the discounted price together with the coupon with increased UsageCount
+func (c Coupon) Redeem(p Price, at time.Time) (Price, Coupon, error) {
+	if !c.IsRedeemable(at) {
+		return p, c, errors.New("coupon " + c.Code + " is not redeemable")
+	}
+	result, _, err := Discounts{c.Discount}.ApplyAt(p, StackingSequential, at)
+	if err != nil {
+		return p, c, err
+	}
+	c.UsageCount++
+	return result, c, nil
+}
+
+// Value makes the Coupon struct implement the driver.Valuer interface. This method
+// simply returns the JSON-encoded representation of the struct.
+func (c Coupon) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan makes the Coupon struct implement the sql.Scanner interface. This method
+// simply decodes a JSON-encoded value into the struct fields.
+func (c *Coupon) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &c)
+}
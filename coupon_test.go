@@ -0,0 +1,71 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoupon_Validate(t *testing.T) {
+	assert.NoError(t, Coupon{Code: "SUMMER", Scope: CouponScopeCart}.Validate())
+	assert.Error(t, Coupon{Scope: CouponScopeCart}.Validate())
+	assert.Error(t, Coupon{Code: "SUMMER", Scope: "order"}.Validate())
+	assert.Error(t, Coupon{Code: "SUMMER", Scope: CouponScopeItem, UsageLimit: -1}.Validate())
+}
+
+func TestCoupon_Redeem(t *testing.T) {
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	coupon := Coupon{
+		Code:       "SUMMER",
+		Discount:   Discount{Percentage: 10},
+		Scope:      CouponScopeCart,
+		UsageLimit: 1,
+	}
+
+	assert.True(t, coupon.IsRedeemable(now))
+	result, used, err := coupon.Redeem(NewFromInt(50, 1, "EUR"), now)
+	require.NoError(t, err)
+	assert.Equal(t, "45.00", result.Amount().Text('f', 2))
+	assert.Equal(t, 1, used.UsageCount)
+	assert.Equal(t, 0, coupon.UsageCount, "original is not changed")
+
+	assert.True(t, used.IsExhausted())
+	_, _, err = used.Redeem(NewFromInt(50, 1, "EUR"), now)
+	assert.Error(t, err)
+
+	expired := now.Add(-time.Hour)
+	coupon.Discount.ValidUntil = &expired
+	assert.False(t, coupon.IsRedeemable(now))
+
+	assert.False(t, Coupon{UsageCount: 100}.IsExhausted(), "no limit")
+}
+
+func TestCoupon_ValueScan(t *testing.T) {
+	coupon := Coupon{
+		Code:       "SHIP",
+		Discount:   Discount{Price: NewFromInt(5, 1, "EUR")},
+		Scope:      CouponScopeShipping,
+		UsageLimit: 10,
+		UsageCount: 3,
+	}
+
+	value, err := coupon.Value()
+	require.NoError(t, err)
+
+	var scanned Coupon
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, coupon.Code, scanned.Code)
+	assert.Equal(t, coupon.Scope, scanned.Scope)
+	assert.Equal(t, coupon.UsageLimit, scanned.UsageLimit)
+	assert.Equal(t, coupon.UsageCount, scanned.UsageCount)
+	assert.True(t, scanned.Discount.Price.Equal(coupon.Discount.Price))
+
+	assert.Error(t, scanned.Scan("SHIP"))
+
+	data, err := json.Marshal(coupon)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code":"SHIP","discount":{"price":{"amount":"5","currency":"EUR"}},"scope":"shipping","usageLimit":10,"usageCount":3}`, string(data))
+}
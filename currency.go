@@ -0,0 +1,134 @@
+package price
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+type (
+	// CurrencyInfo holds the ISO 4217 metadata of a currency
+	CurrencyInfo struct {
+		// Code is the alphabetic currency code, e.g. "EUR"
+		Code string
+		// MinorUnits is the number of decimal digits of the currency, e.g. 2 for EUR and 0 for JPY
+		MinorUnits int
+	}
+
+	// Currency is a normalized currency code, e.g. "EUR" for "eur", "Eur" or " EUR ".
+	// Prices keep their currency as string for compatibility, use NewCurrency to compare them case-insensitive
+	Currency string
+)
+
+var (
+	currenciesMu sync.RWMutex
+	// currencies holds the ISO 4217 table by upper case code, currencies not listed have two minor units
+	currencies = map[string]CurrencyInfo{}
+)
+
+func init() {
+	twoDigits := []string{
+		"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN", "BAM", "BBD", "BDT", "BGN", "BMD",
+		"BND", "BOB", "BOV", "BRL", "BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CNY",
+		"COP", "COU", "CRC", "CUP", "CVE", "CZK", "DKK", "DOP", "DZD", "EGP", "ERN", "ETB", "EUR", "FJD", "FKP",
+		"GBP", "GEL", "GHS", "GIP", "GMD", "GTQ", "GYD", "HKD", "HNL", "HTG", "HUF", "IDR", "ILS", "INR", "IRR",
+		"JMD", "KES", "KGS", "KHR", "KPW", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "MAD", "MDL", "MGA",
+		"MKD", "MMK", "MNT", "MOP", "MRU", "MUR", "MVR", "MWK", "MXN", "MXV", "MYR", "MZN", "NAD", "NGN", "NIO",
+		"NOK", "NPR", "NZD", "PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "QAR", "RON", "RSD", "RUB", "SAR", "SBD",
+		"SCR", "SDG", "SEK", "SGD", "SHP", "SLE", "SOS", "SRD", "SSP", "STN", "SVC", "SYP", "SZL", "THB", "TJS",
+		"TMT", "TOP", "TRY", "TTD", "TWD", "TZS", "UAH", "USD", "USN", "UYU", "UZS", "VED", "VES", "WST", "XCD",
+		"XCG", "YER", "ZAR", "ZMW", "ZWG",
+	}
+	zeroDigits := []string{
+		"BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "UYI", "VND", "VUV", "XAF",
+		"XOF", "XPF",
+	}
+	threeDigits := []string{"BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND"}
+	fourDigits := []string{"CLF", "UYW"}
+
+	for digits, codes := range [][]string{zeroDigits, nil, twoDigits, threeDigits, fourDigits} {
+		for _, code := range codes {
+			currencies[code] = CurrencyInfo{Code: code, MinorUnits: digits}
+		}
+	}
+}
+
+// LookupCurrency returns the metadata of the currency (case-insensitive),
+// the second return value is false if the currency is not registered
+func LookupCurrency(code string) (CurrencyInfo, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+	info, ok := currencies[strings.ToUpper(code)]
+	return info, ok
+}
+
+// RegisterCurrency adds a custom currency or overrides the metadata of an ISO 4217 currency
+func RegisterCurrency(info CurrencyInfo) error {
+	if info.Code == "" {
+		return errors.New("currency code must not be empty")
+	}
+	if info.MinorUnits < 0 || info.MinorUnits > 18 {
+		return errors.New("minor units must be between 0 and 18")
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	info.Code = strings.ToUpper(info.Code)
+	currencies[info.Code] = info
+	return nil
+}
+
+// Precision returns the factor of the smallest currency unit, e.g. 100 for two minor units
+func (c CurrencyInfo) Precision() int {
+	precision := 1
+	for i := 0; i < c.MinorUnits; i++ {
+		precision *= 10
+	}
+	return precision
+}
+
+// NewCurrency returns the normalized currency code, surrounding spaces are removed and letters are upper case
+func NewCurrency(code string) Currency {
+	return Currency(strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// ParseCurrency returns the normalized currency code, an error is returned if it is not registered (see IsValid)
+func ParseCurrency(code string) (Currency, error) {
+	c := NewCurrency(code)
+	if !c.IsValid() {
+		return c, newDetailedError(ErrUnknownCurrency, "unknown currency "+code)
+	}
+	return c, nil
+}
+
+// String returns the currency code
+func (c Currency) String() string {
+	return string(c)
+}
+
+// IsValid returns true if the currency is an ISO 4217 currency or registered with RegisterCurrency
+func (c Currency) IsValid() bool {
+	_, ok := c.Info()
+	return ok
+}
+
+// Info returns the metadata of the currency, see LookupCurrency
+func (c Currency) Info() (CurrencyInfo, bool) {
+	return LookupCurrency(string(c))
+}
+
+// Equal compares the normalized currency codes
+func (c Currency) Equal(other Currency) bool {
+	return NewCurrency(string(c)) == NewCurrency(string(other))
+}
+
+// MarshalText returns the normalized currency code
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(NewCurrency(string(c))), nil
+}
+
+// UnmarshalText normalizes the currency code, unknown currencies are accepted, use IsValid to check them
+func (c *Currency) UnmarshalText(text []byte) error {
+	*c = NewCurrency(string(text))
+	return nil
+}
@@ -0,0 +1,45 @@
+package price
+
+import (
+	"sync/atomic"
+)
+
+// strictCurrencyGuard is 1 if the strict currency guard is enabled, see SetStrictCurrencyGuard
+var strictCurrencyGuard int32
+
+// SetStrictCurrencyGuard enables or disables the strict currency guard for all calculations.
+// By default a zero price adopts the currency of the other price, e.g. 0 EUR + 5 USD is 5 USD.
+// In strict mode any currency mismatch returns an error, including zero prices. Use AddStrict and SubStrict
+// to check single calculations strictly
+func SetStrictCurrencyGuard(strict bool) {
+	var value int32
+	if strict {
+		value = 1
+	}
+	atomic.StoreInt32(&strictCurrencyGuard, value)
+}
+
+// IsStrictCurrencyGuard returns true if the strict currency guard is enabled
+func IsStrictCurrencyGuard() bool {
+	return atomic.LoadInt32(&strictCurrencyGuard) == 1
+}
+
+// AddStrict adds the given price like Add but returns an error for any currency mismatch, also for zero prices
+func (p Price) AddStrict(add Price) (Price, error) {
+	newPrice, err := p.currencyGuardWith(add, true)
+	if err != nil {
+		return newPrice, err
+	}
+	newPrice.amount.Add(&p.amount, &add.amount)
+	return newPrice, nil
+}
+
+// SubStrict subtracts the given price like Sub but returns an error for any currency mismatch, also for zero prices
+func (p Price) SubStrict(sub Price) (Price, error) {
+	newPrice, err := p.currencyGuardWith(sub, true)
+	if err != nil {
+		return newPrice, err
+	}
+	newPrice.amount.Sub(&p.amount, &sub.amount)
+	return newPrice, nil
+}
@@ -0,0 +1,53 @@
+package price_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestPrice_AddStrict(t *testing.T) {
+	zero := price.NewZero("EUR")
+	usd := price.NewFromInt(500, 100, "USD")
+
+	sum, err := zero.Add(usd)
+	require.NoError(t, err, "lenient by default")
+	assert.Equal(t, "USD", sum.Currency())
+
+	_, err = zero.AddStrict(usd)
+	assert.Error(t, err)
+	_, err = usd.SubStrict(zero)
+	assert.Error(t, err)
+
+	sum, err = usd.AddStrict(usd)
+	require.NoError(t, err)
+	assert.True(t, sum.Equal(price.NewFromInt(1000, 100, "USD")))
+}
+
+func TestSetStrictCurrencyGuard(t *testing.T) {
+	price.SetStrictCurrencyGuard(true)
+	t.Cleanup(func() { price.SetStrictCurrencyGuard(false) })
+	assert.True(t, price.IsStrictCurrencyGuard())
+
+	zero := price.NewZero("EUR")
+	usd := price.NewFromInt(500, 100, "USD")
+
+	_, err := zero.Add(usd)
+	assert.Error(t, err)
+	_, err = usd.Sub(zero)
+	assert.Error(t, err)
+	_, err = price.SumAll(zero, usd)
+	assert.Error(t, err)
+	assert.Equal(t, usd, usd.ForceAdd(zero))
+
+	_, err = price.NewExactZero("EUR").Add(price.NewExactFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+
+	var sum price.Accumulator
+	require.NoError(t, sum.Add(usd), "an accumulator without currency takes the first currency")
+	assert.Error(t, sum.Add(zero))
+	assert.Error(t, price.NewAccumulator("EUR").Add(usd))
+}
@@ -0,0 +1,100 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	tests := []struct {
+		code       string
+		minorUnits int
+	}{
+		{code: "EUR", minorUnits: 2},
+		{code: "usd", minorUnits: 2},
+		{code: "JPY", minorUnits: 0},
+		{code: "KRW", minorUnits: 0},
+		{code: "BHD", minorUnits: 3},
+		{code: "KWD", minorUnits: 3},
+		{code: "CLF", minorUnits: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			info, ok := LookupCurrency(tt.code)
+			require.True(t, ok)
+			assert.Equal(t, tt.minorUnits, info.MinorUnits)
+		})
+	}
+
+	_, ok := LookupCurrency("XYZ")
+	assert.False(t, ok)
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	assert.Error(t, RegisterCurrency(CurrencyInfo{}))
+	assert.Error(t, RegisterCurrency(CurrencyInfo{Code: "XTS", MinorUnits: -1}))
+
+	currenciesMu.RLock()
+	previous, registered := currencies["XTS"]
+	currenciesMu.RUnlock()
+	t.Cleanup(func() {
+		currenciesMu.Lock()
+		defer currenciesMu.Unlock()
+		if registered {
+			currencies["XTS"] = previous
+		} else {
+			delete(currencies, "XTS")
+		}
+	})
+
+	require.NoError(t, RegisterCurrency(CurrencyInfo{Code: "xts", MinorUnits: 4}))
+	info, ok := LookupCurrency("XTS")
+	require.True(t, ok)
+	assert.Equal(t, "XTS", info.Code)
+	assert.Equal(t, 10000, info.Precision())
+
+	assert.Equal(t, "1.2346", NewFromFloat(1.23456, "XTS").GetPayable().Amount().Text('f', 4))
+}
+
+func TestPrice_GetPayable_CurrencyMinorUnits(t *testing.T) {
+	assert.Equal(t, "1235.000", NewFromFloat(1234.5, "JPY").GetPayable().Amount().Text('f', 3))
+	assert.Equal(t, "1.235", NewFromFloat(1.2345, "BHD").GetPayable().Amount().Text('f', 3))
+	assert.Equal(t, "1.230", NewFromFloat(1.2345, "EUR").GetPayable().Amount().Text('f', 3))
+	assert.Equal(t, "1.230", NewFromFloat(1.2345, "unknown").GetPayable().Amount().Text('f', 3))
+}
+
+func TestCurrency(t *testing.T) {
+	assert.Equal(t, Currency("EUR"), NewCurrency(" eur "))
+	assert.Equal(t, Currency("EUR"), NewFromFloat(1, "Eur").CurrencyCode())
+	assert.True(t, Currency("eur").Equal("EUR"))
+	assert.False(t, Currency("EUR").Equal("USD"))
+
+	assert.True(t, NewCurrency("jpy").IsValid())
+	assert.False(t, NewCurrency("points").IsValid())
+	assert.False(t, NewCurrency("").IsValid())
+
+	info, ok := NewCurrency("kwd").Info()
+	require.True(t, ok)
+	assert.Equal(t, 3, info.MinorUnits)
+
+	c, err := ParseCurrency("chf")
+	require.NoError(t, err)
+	assert.Equal(t, "CHF", c.String())
+	_, err = ParseCurrency("XYZ")
+	assert.Error(t, err)
+
+	var decoded struct {
+		Currency Currency `json:"currency"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"currency":"usd"}`), &decoded))
+	assert.Equal(t, Currency("USD"), decoded.Currency)
+	data, err := json.Marshal(struct {
+		Currency Currency `json:"currency"`
+	}{Currency: "gbp"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"currency":"GBP"}`, string(data))
+}
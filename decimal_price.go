@@ -0,0 +1,250 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+type (
+	// Decimal is the amount representation of a pluggable decimal backend.
+	// Implementations must be immutable and Parse must also work on the zero value, e.g. an adapter for shopspring/decimal:
+	//
+	//	type Dec struct{ decimal.Decimal }
+	//
+	//	func (d Dec) Add(o Dec) Dec                  { return Dec{d.Decimal.Add(o.Decimal)} }
+	//	func (d Dec) Sub(o Dec) Dec                  { return Dec{d.Decimal.Sub(o.Decimal)} }
+	//	func (d Dec) Mul(o Dec) Dec                  { return Dec{d.Decimal.Mul(o.Decimal)} }
+	//	func (d Dec) Cmp(o Dec) int                  { return d.Decimal.Cmp(o.Decimal) }
+	//	func (Dec) Parse(s string) (Dec, error)     { v, err := decimal.NewFromString(s); return Dec{v}, err }
+	Decimal[D any] interface {
+		Add(D) D
+		Sub(D) D
+		Mul(D) D
+		Cmp(D) int
+		// Parse returns a new decimal from a decimal string like "-12.345"
+		Parse(string) (D, error)
+		// String returns the exact amount, a decimal string or a fraction like "1/3" that big.Rat can parse
+		String() string
+	}
+
+	// DecimalPrice is an immutable price with an amount of a pluggable Decimal backend.
+	// It offers the core Price API with the same JSON format and rounding semantics
+	DecimalPrice[D Decimal[D]] struct {
+		amount   D
+		currency string
+	}
+
+	// RatDecimal is a Decimal backend based on big.Rat without external dependencies
+	RatDecimal struct {
+		rat big.Rat
+	}
+)
+
+// decimalFactorDigits is the count of digits used to pass repeating factors (e.g. a discount of 1/3 %) to a backend
+const decimalFactorDigits = 34
+
+// NewDecimalPrice - factory method
+func NewDecimalPrice[D Decimal[D]](amount D, currency string) DecimalPrice[D] {
+	return DecimalPrice[D]{
+		amount:   amount,
+		currency: currency,
+	}
+}
+
+// ParseDecimalPrice parses the amount with the backend D
+func ParseDecimalPrice[D Decimal[D]](amount string, currency string) (DecimalPrice[D], error) {
+	var zero D
+	d, err := zero.Parse(amount)
+	if err != nil {
+		return DecimalPrice[D]{currency: currency}, err
+	}
+	return NewDecimalPrice(d, currency), nil
+}
+
+// DecimalPriceFrom converts a price to the backend D, see Price.Exact for how the amount is read
+func DecimalPriceFrom[D Decimal[D]](p Price) (DecimalPrice[D], error) {
+	if p.amount.IsInf() {
+		return DecimalPrice[D]{currency: p.currency}, ErrNotFinite
+	}
+	return ParseDecimalPrice[D](p.canonicalAmount(), p.currency)
+}
+
+// Amount returns the amount of the backend
+func (p DecimalPrice[D]) Amount() D {
+	return p.amount
+}
+
+// Currency returns currency
+func (p DecimalPrice[D]) Currency() string {
+	return p.currency
+}
+
+// Exact returns the amount as ExactPrice
+func (p DecimalPrice[D]) Exact() (ExactPrice, error) {
+	return ParseExact(p.amount.String(), p.currency)
+}
+
+// Price returns the amount as Price
+func (p DecimalPrice[D]) Price() (Price, error) {
+	exact, err := p.Exact()
+	if err != nil {
+		return NewZero(p.currency), err
+	}
+	return exact.Price(), nil
+}
+
+// Add the given price to the current price and returns a new price
+func (p DecimalPrice[D]) Add(add DecimalPrice[D]) (DecimalPrice[D], error) {
+	if p.currency != add.currency {
+		return p, ErrCurrencyMismatch
+	}
+	return NewDecimalPrice(p.amount.Add(add.amount), p.currency), nil
+}
+
+// Sub the given price from the current price and returns a new price
+func (p DecimalPrice[D]) Sub(sub DecimalPrice[D]) (DecimalPrice[D], error) {
+	if p.currency != sub.currency {
+		return p, ErrCurrencyMismatch
+	}
+	return NewDecimalPrice(p.amount.Sub(sub.amount), p.currency), nil
+}
+
+// Multiply returns a new price with the amount multiplied by qty
+func (p DecimalPrice[D]) Multiply(qty int) (DecimalPrice[D], error) {
+	return p.mulString(strconv.Itoa(qty))
+}
+
+// DiscountedBy returns new price reduced by given percent
+func (p DecimalPrice[D]) DiscountedBy(percent Percent) (DecimalPrice[D], error) {
+	return p.mulRat(new(big.Rat).Sub(big.NewRat(1, 1), new(big.Rat).Quo(&percent.rat, big.NewRat(100, 1))))
+}
+
+// TaxedBy returns new price increased by given percent
+func (p DecimalPrice[D]) TaxedBy(percent Percent) (DecimalPrice[D], error) {
+	return p.mulRat(new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Quo(&percent.rat, big.NewRat(100, 1))))
+}
+
+// mulRat multiplies the amount by the factor, repeating factors are cut after decimalFactorDigits digits
+func (p DecimalPrice[D]) mulRat(factor *big.Rat) (DecimalPrice[D], error) {
+	s, _ := ratDecimalString(factor, decimalFactorDigits)
+	return p.mulString(s)
+}
+
+func (p DecimalPrice[D]) mulString(factor string) (DecimalPrice[D], error) {
+	f, err := p.amount.Parse(factor)
+	if err != nil {
+		return p, err
+	}
+	return NewDecimalPrice(p.amount.Mul(f), p.currency), nil
+}
+
+// Cmp compares the amounts, prices in different currencies cannot be compared
+func (p DecimalPrice[D]) Cmp(cmp DecimalPrice[D]) (int, error) {
+	if p.currency != cmp.currency {
+		return 0, newDetailedError(ErrCurrencyMismatch, "cannot compare prices in different currencies")
+	}
+	return p.amount.Cmp(cmp.amount), nil
+}
+
+// Equal compares currency and amount
+func (p DecimalPrice[D]) Equal(cmp DecimalPrice[D]) bool {
+	return p.currency == cmp.currency && p.amount.Cmp(cmp.amount) == 0
+}
+
+// GetPayable rounds the amount with the rounding configuration of the currency, see Price.GetPayable
+func (p DecimalPrice[D]) GetPayable() (DecimalPrice[D], error) {
+	mode, precision := Price{currency: p.currency}.payableRoundingPrecision()
+	return p.GetPayableByRoundingMode(mode, precision)
+}
+
+// GetPayableByRoundingMode rounds the amount exactly with the given mode and precision, see Price.GetPayableByRoundingMode
+func (p DecimalPrice[D]) GetPayableByRoundingMode(mode RoundingMode, precision int) (DecimalPrice[D], error) {
+	exact, err := p.Exact()
+	if err != nil {
+		return p, err
+	}
+	payable := exact.GetPayableByRoundingMode(mode, precision)
+	return ParseDecimalPrice[D](payable.canonicalAmount(), p.currency)
+}
+
+func (p DecimalPrice[D]) String() string {
+	bytes, _ := p.MarshalText()
+	return string(bytes)
+}
+
+// MarshalText uses the JSON format of Price
+func (p DecimalPrice[D]) MarshalText() ([]byte, error) {
+	return json.Marshal(&priceJSON{
+		Amount:   p.amount.String(),
+		Currency: p.currency,
+	})
+}
+
+// UnmarshalText reads the JSON format of Price
+func (p *DecimalPrice[D]) UnmarshalText(b []byte) error {
+	pj := &priceJSON{}
+	if err := json.Unmarshal(b, pj); err != nil {
+		return err
+	}
+	parsed, err := ParseDecimalPrice[D](pj.Amount, pj.Currency)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// MarshalJSON implements interface required by json marshal
+func (p DecimalPrice[D]) MarshalJSON() ([]byte, error) {
+	return p.MarshalText()
+}
+
+// UnmarshalJSON implements encode Unmarshaler
+func (p *DecimalPrice[D]) UnmarshalJSON(data []byte) error {
+	return p.UnmarshalText(data)
+}
+
+// Add returns a + b
+func (a RatDecimal) Add(b RatDecimal) RatDecimal {
+	return ratDecimal(new(big.Rat).Add(&a.rat, &b.rat))
+}
+
+// Sub returns a - b
+func (a RatDecimal) Sub(b RatDecimal) RatDecimal {
+	return ratDecimal(new(big.Rat).Sub(&a.rat, &b.rat))
+}
+
+// Mul returns a * b
+func (a RatDecimal) Mul(b RatDecimal) RatDecimal {
+	return ratDecimal(new(big.Rat).Mul(&a.rat, &b.rat))
+}
+
+// Cmp compares a and b like big.Rat.Cmp
+func (a RatDecimal) Cmp(b RatDecimal) int {
+	return a.rat.Cmp(&b.rat)
+}
+
+// Parse reads a decimal or fraction
+func (RatDecimal) Parse(s string) (RatDecimal, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return RatDecimal{}, errors.New("invalid decimal " + s)
+	}
+	return ratDecimal(r), nil
+}
+
+// String returns the exact decimal, repeating decimals are written as fraction
+func (a RatDecimal) String() string {
+	if s, exact := ratDecimalString(&a.rat, 0); exact {
+		return s
+	}
+	return a.rat.String()
+}
+
+func ratDecimal(r *big.Rat) RatDecimal {
+	d := RatDecimal{}
+	d.rat.Set(r)
+	return d
+}
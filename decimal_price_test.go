@@ -0,0 +1,93 @@
+package price_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+// fixed4 is a fixed point test backend with four decimals
+type fixed4 int64
+
+func (a fixed4) Add(b fixed4) fixed4 { return a + b }
+func (a fixed4) Sub(b fixed4) fixed4 { return a - b }
+func (a fixed4) Mul(b fixed4) fixed4 { return a * b / 10000 }
+func (a fixed4) Cmp(b fixed4) int {
+	return big.NewInt(int64(a)).Cmp(big.NewInt(int64(b)))
+}
+func (fixed4) Parse(s string) (fixed4, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, errors.New("invalid decimal")
+	}
+	r.Mul(r, big.NewRat(10000, 1))
+	return fixed4(new(big.Int).Quo(r.Num(), r.Denom()).Int64()), nil
+}
+func (a fixed4) String() string {
+	return new(big.Rat).SetFrac64(int64(a), 10000).FloatString(4)
+}
+
+func TestDecimalPrice_RatDecimal(t *testing.T) {
+	p, err := price.ParseDecimalPrice[price.RatDecimal]("0.1", "EUR")
+	require.NoError(t, err)
+
+	sum := p
+	for i := 0; i < 9; i++ {
+		sum, err = sum.Add(p)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "1", sum.Amount().String())
+
+	discounted, err := sum.DiscountedBy(price.NewPercent(10))
+	require.NoError(t, err)
+	assert.Equal(t, "0.9", discounted.Amount().String())
+
+	_, err = sum.Add(price.NewDecimalPrice(sum.Amount(), "USD"))
+	assert.Error(t, err)
+}
+
+func TestDecimalPrice_GetPayable(t *testing.T) {
+	p, err := price.ParseDecimalPrice[fixed4]("1.115", "EUR")
+	require.NoError(t, err)
+
+	payable, err := p.GetPayable()
+	require.NoError(t, err)
+	assert.Equal(t, "1.1200", payable.Amount().String())
+
+	negative, err := price.ParseDecimalPrice[fixed4]("-1.115", "EUR")
+	require.NoError(t, err)
+	floor, err := negative.GetPayableByRoundingMode(price.RoundingModeFloor, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "-1.1200", floor.Amount().String())
+
+	taxed, err := p.TaxedBy(price.NewPercent(19))
+	require.NoError(t, err)
+	assert.Equal(t, "1.3268", taxed.Amount().String())
+}
+
+func TestDecimalPrice_JSON(t *testing.T) {
+	p, err := price.DecimalPriceFrom[fixed4](price.NewFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.3400","currency":"EUR"}`, string(data))
+
+	var legacy price.Price
+	require.NoError(t, json.Unmarshal(data, &legacy))
+	assert.True(t, legacy.Equal(price.NewFromInt(1234, 100, "EUR")))
+
+	var parsed price.DecimalPrice[fixed4]
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	assert.True(t, parsed.Equal(p))
+
+	back, err := parsed.Price()
+	require.NoError(t, err)
+	assert.True(t, back.LikelyEqual(legacy))
+}
@@ -4,16 +4,106 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Discount represents the amount of discount in Price or percentage.
 // Percentage is priority used over Price.
+// Fractional percentages like 12.5% are set with BasisPoints (1250), which is used instead of Percentage if set.
+// MaxAmount caps the discount amount and MinSpend is the minimum price the discount applies to, both are optional.
+// ValidFrom (inclusive) and ValidUntil (exclusive) limit the time the discount is active, both are optional.
 // To get discounted Price, use Discounted func for percentage
 //
 //	or Sub func for Price.
 type Discount struct {
-	Price      Price `db:"price,omitempty" firestore:"price,omitempty" json:"price,omitempty"`
-	Percentage int   `db:"percentage,omitempty" firestore:"percentage,omitempty" json:"percentage,omitempty"`
+	Label       string     `db:"label,omitempty" firestore:"label,omitempty" json:"label,omitempty"`
+	Price       Price      `db:"price,omitempty" firestore:"price,omitempty" json:"price,omitempty"`
+	Percentage  int        `db:"percentage,omitempty" firestore:"percentage,omitempty" json:"percentage,omitempty"`
+	BasisPoints int64      `db:"basis_points,omitempty" firestore:"basisPoints,omitempty" json:"basisPoints,omitempty"`
+	MaxAmount   *Price     `db:"max_amount,omitempty" firestore:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+	MinSpend    *Price     `db:"min_spend,omitempty" firestore:"minSpend,omitempty" json:"minSpend,omitempty"`
+	ValidFrom   *time.Time `db:"valid_from,omitempty" firestore:"validFrom,omitempty" json:"validFrom,omitempty"`
+	ValidUntil  *time.Time `db:"valid_until,omitempty" firestore:"validUntil,omitempty" json:"validUntil,omitempty"`
+}
+
+// NewPercentDiscount returns a discount of the given percent, whole percentages are stored in Percentage
+// so that the JSON stays readable for older versions, fractional ones in BasisPoints
+func NewPercentDiscount(percent Percent) (Discount, error) {
+	bp := new(big.Rat).Mul(percent.Rat(), big.NewRat(100, 1))
+	if !bp.IsInt() || !bp.Num().IsInt64() {
+		return Discount{}, errors.New("percentage must be a multiple of a basis point")
+	}
+	if percent.Rat().IsInt() {
+		return Discount{Percentage: int(bp.Num().Int64() / 100)}, nil
+	}
+	return Discount{BasisPoints: bp.Num().Int64()}, nil
+}
+
+// Percent returns the percentage of the Discount as Percent, BasisPoints is preferred over Percentage
+func (a Discount) Percent() Percent {
+	if a.BasisPoints != 0 {
+		return NewPercentFromBasisPoints(a.BasisPoints)
+	}
+	return NewPercent(int64(a.Percentage))
+}
+
+// Amount returns the payable discount amount for p, a percentage is preferred over Price.
+// The amount is zero if p is below MinSpend and capped at MaxAmount
+func (a Discount) Amount(p Price) (Price, error) {
+	if a.MinSpend != nil {
+		if a.MinSpend.Currency() != p.Currency() {
+			return NewZero(p.Currency()), ErrCurrencyMismatch
+		}
+		if p.IsLessThen(*a.MinSpend) {
+			return NewZero(p.Currency()), nil
+		}
+	}
+
+	amount, err := a.uncappedAmount(p)
+	if err != nil || a.MaxAmount == nil {
+		return amount, err
+	}
+	if a.MaxAmount.Currency() != p.Currency() {
+		return NewZero(p.Currency()), ErrCurrencyMismatch
+	}
+	if amount.IsGreaterThen(*a.MaxAmount) {
+		return a.MaxAmount.GetPayable(), nil
+	}
+	return amount, nil
+}
+
+func (a Discount) uncappedAmount(p Price) (Price, error) {
+	if percent := a.Percent(); !percent.IsZero() {
+		return percent.Of(p).GetPayable(), nil
+	}
+	if a.Price.IsZero() {
+		return NewZero(p.Currency()), nil
+	}
+	if a.Price.Currency() != p.Currency() {
+		return NewZero(p.Currency()), ErrCurrencyMismatch
+	}
+	return a.Price.GetPayable(), nil
+}
+
+// IsActive returns true if the discount is valid at the given time
+func (a Discount) IsActive(at time.Time) bool {
+	if a.ValidFrom != nil && at.Before(*a.ValidFrom) {
+		return false
+	}
+	if a.ValidUntil != nil && !at.Before(*a.ValidUntil) {
+		return false
+	}
+	return true
+}
+
+// Apply returns p reduced by the discount, the result is never below zero.
+// An inactive discount returns p unchanged
+func (a Discount) Apply(p Price) (Price, error) {
+	result, _, err := Discounts{a}.Apply(p, StackingSequential)
+	return result, err
 }
 
 // Value makes the Discount struct implement the driver.Valuer interface. This method
@@ -23,12 +113,111 @@ func (a Discount) Value() (driver.Value, error) {
 }
 
 // Scan makes the Discount struct implement the sql.Scanner interface. This method
-// simply decodes a JSON-encoded value into the struct fields.
+// decodes a JSON-encoded value into the struct fields. Plain numbers are read as percentage
+// like NewPercentDiscount, NULL sets an empty discount
 func (a *Discount) Scan(value interface{}) error {
-	b, ok := value.([]byte)
-	if !ok {
-		return errors.New("type assertion to []byte failed")
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*a = Discount{}
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return errors.New("unsupported type for discount")
 	}
 
-	return json.Unmarshal(b, &a)
+	if trimmed := strings.TrimSpace(s); strings.HasPrefix(trimmed, "{") {
+		return json.Unmarshal([]byte(trimmed), a)
+	}
+	percent, err := ParsePercent(s)
+	if err != nil {
+		return err
+	}
+	scanned, err := NewPercentDiscount(percent)
+	if err != nil {
+		return err
+	}
+	*a = scanned
+	return nil
+}
+
+// Discounts is a list of discounts applied in order
+type Discounts []Discount
+
+// AppliedDiscount is the monetary effect of one discount, e.g. for a receipt line
+type AppliedDiscount struct {
+	Label string `json:"label,omitempty"`
+	// Input is the price before the discount
+	Input Price `json:"input"`
+	// Amount is the payable amount the price was reduced by
+	Amount Price `json:"amount"`
+	// Output is the price after the discount
+	Output Price `json:"output"`
+}
+
+// StackingMode defines how multiple discounts are combined
+type StackingMode string
+
+const (
+	// StackingSequential applies each discount to the price reduced by the previous ones (compounding),
+	// 10% and 10% result in 19% off
+	StackingSequential StackingMode = "sequential"
+	// StackingAdditive applies each discount to the original price, 10% and 10% result in 20% off
+	StackingAdditive StackingMode = "additive"
+)
+
+// Apply applies all discounts active now to p, see ApplyAt
+func (d Discounts) Apply(p Price, mode StackingMode) (Price, []AppliedDiscount, error) {
+	return d.ApplyAt(p, mode, time.Now())
+}
+
+// ApplyAt applies all discounts active at the given time to p and returns the discounted price together with the applied amount
+// of each discount, so that p minus all amounts equals the result. Inactive discounts are skipped and not part of the breakdown.
+// Amounts are limited so that the result is never below zero.
+func (d Discounts) ApplyAt(p Price, mode StackingMode, at time.Time) (Price, []AppliedDiscount, error) {
+	if mode != StackingSequential && mode != StackingAdditive {
+		return p, nil, errors.New("unknown stacking mode " + string(mode))
+	}
+
+	current := p.Clone()
+	applied := make([]AppliedDiscount, 0, len(d))
+	for _, discount := range d {
+		if !discount.IsActive(at) {
+			continue
+		}
+		base := current
+		if mode == StackingAdditive {
+			base = p
+		}
+		amount, err := discount.Amount(base)
+		if err != nil {
+			return p, nil, err
+		}
+		if amount.IsGreaterThen(current) {
+			amount = current
+		}
+		if current.IsNegative() || amount.IsNegative() {
+			amount = NewZero(p.Currency())
+		}
+
+		input := current
+		current, err = current.Sub(amount)
+		if err != nil {
+			return p, nil, err
+		}
+		applied = append(applied, AppliedDiscount{
+			Label:  discount.Label,
+			Input:  input,
+			Amount: amount,
+			Output: current,
+		})
+	}
+	return current, applied, nil
 }
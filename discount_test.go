@@ -0,0 +1,254 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscount_BasisPoints(t *testing.T) {
+	discount := Discount{BasisPoints: 1250}
+	assert.Equal(t, "12.5%", discount.Percent().String())
+
+	discount = Discount{Percentage: 10, BasisPoints: 275}
+	assert.Equal(t, "2.75%", discount.Percent().String(), "basis points are preferred")
+
+	assert.True(t, NewFromInt(100, 1, "EUR").DiscountedBy(Discount{BasisPoints: 1250}.Percent()).Equal(NewFromInt(875, 10, "EUR")))
+}
+
+func TestNewPercentDiscount(t *testing.T) {
+	discount, err := NewPercentDiscount(NewPercent(15))
+	require.NoError(t, err)
+	assert.Equal(t, Discount{Percentage: 15}, discount)
+
+	discount, err = NewPercentDiscount(NewPercentFromFloat(2.75))
+	require.NoError(t, err)
+	assert.Equal(t, Discount{BasisPoints: 275}, discount)
+
+	_, err = NewPercentDiscount(NewPercentFromFloat(2.755))
+	assert.Error(t, err)
+}
+
+func TestDiscount_JSONCompatibility(t *testing.T) {
+	var legacy Discount
+	require.NoError(t, json.Unmarshal([]byte(`{"percentage":15}`), &legacy))
+	assert.True(t, legacy.Percent().Equal(NewPercent(15)))
+
+	data, err := json.Marshal(Discount{Percentage: 15})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"0"},"percentage":15}`, string(data))
+
+	data, err = json.Marshal(Discount{BasisPoints: 1250})
+	require.NoError(t, err)
+
+	var decoded Discount
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "12.5%", decoded.Percent().String())
+}
+
+func TestDiscount_Amount(t *testing.T) {
+	price := NewFromInt(80, 1, "EUR")
+
+	amount, err := Discount{Percentage: 10, Price: NewFromInt(5, 1, "EUR")}.Amount(price)
+	require.NoError(t, err)
+	assert.True(t, amount.Equal(NewFromInt(8, 1, "EUR")), "percentage is preferred")
+
+	amount, err = Discount{Price: NewFromInt(5, 1, "EUR")}.Amount(price)
+	require.NoError(t, err)
+	assert.True(t, amount.Equal(NewFromInt(5, 1, "EUR")))
+
+	amount, err = Discount{}.Amount(price)
+	require.NoError(t, err)
+	assert.True(t, amount.IsZero())
+
+	_, err = Discount{Price: NewFromInt(5, 1, "USD")}.Amount(price)
+	assert.Error(t, err)
+}
+
+func TestDiscount_Apply(t *testing.T) {
+	result, err := Discount{BasisPoints: 1250}.Apply(NewFromInt(80, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "70.00", result.Amount().Text('f', 2))
+
+	result, err = Discount{Price: NewFromInt(100, 1, "EUR")}.Apply(NewFromInt(80, 1, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, result.IsZero(), "never below zero")
+}
+
+func TestDiscounts_Apply(t *testing.T) {
+	discounts := Discounts{
+		{Percentage: 10},
+		{Percentage: 10},
+		{Price: NewFromInt(5, 1, "EUR")},
+	}
+
+	tests := []struct {
+		name        string
+		mode        StackingMode
+		wantResult  string
+		wantAmounts []string
+	}{
+		{name: "sequential", mode: StackingSequential, wantResult: "76.00", wantAmounts: []string{"10.00", "9.00", "5.00"}},
+		{name: "additive", mode: StackingAdditive, wantResult: "75.00", wantAmounts: []string{"10.00", "10.00", "5.00"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, applied, err := discounts.Apply(NewFromInt(100, 1, "EUR"), tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result.Amount().Text('f', 2))
+			got := make([]string, 0, len(applied))
+			for _, a := range applied {
+				got = append(got, a.Amount.Amount().Text('f', 2))
+			}
+			assert.Equal(t, tt.wantAmounts, got)
+		})
+	}
+
+	t.Run("limited to price", func(t *testing.T) {
+		result, applied, err := Discounts{{Percentage: 60}, {Percentage: 60}}.Apply(NewFromInt(100, 1, "EUR"), StackingAdditive)
+		require.NoError(t, err)
+		assert.True(t, result.IsZero())
+		assert.Equal(t, "40.00", applied[1].Amount.Amount().Text('f', 2))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := discounts.Apply(NewFromInt(100, 1, "EUR"), StackingMode("unknown"))
+		assert.Error(t, err)
+
+		_, _, err = Discounts{{Price: NewFromInt(5, 1, "USD")}}.Apply(NewFromInt(100, 1, "EUR"), StackingSequential)
+		assert.Error(t, err)
+	})
+}
+
+func TestDiscount_MaxAmountAndMinSpend(t *testing.T) {
+	discount := Discount{
+		Percentage: 20,
+		MaxAmount:  limit(NewFromInt(15, 1, "EUR")),
+		MinSpend:   limit(NewFromInt(50, 1, "EUR")),
+	}
+
+	tests := []struct {
+		name  string
+		price Price
+		want  string
+	}{
+		{name: "below min spend", price: NewFromInt(4999, 100, "EUR"), want: "0.00"},
+		{name: "at min spend", price: NewFromInt(50, 1, "EUR"), want: "10.00"},
+		{name: "capped", price: NewFromInt(100, 1, "EUR"), want: "15.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := discount.Amount(tt.price)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, amount.Amount().Text('f', 2))
+		})
+	}
+
+	result, err := discount.Apply(NewFromInt(100, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "85.00", result.Amount().Text('f', 2))
+
+	_, err = discount.Amount(NewFromInt(100, 1, "USD"))
+	assert.Error(t, err)
+
+	_, err = Discount{Percentage: 20, MaxAmount: limit(NewFromInt(15, 1, "USD"))}.Amount(NewFromInt(100, 1, "EUR"))
+	assert.Error(t, err)
+}
+
+func TestDiscounts_ApplyBreakdown(t *testing.T) {
+	discounts := Discounts{
+		{Label: "summer sale", Percentage: 10},
+		{Label: "voucher", Price: NewFromInt(5, 1, "EUR")},
+	}
+
+	result, applied, err := discounts.Apply(NewFromInt(100, 1, "EUR"), StackingSequential)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+
+	assert.Equal(t, "summer sale", applied[0].Label)
+	assert.Equal(t, "100.00", applied[0].Input.Amount().Text('f', 2))
+	assert.Equal(t, "10.00", applied[0].Amount.Amount().Text('f', 2))
+	assert.Equal(t, "90.00", applied[0].Output.Amount().Text('f', 2))
+
+	assert.Equal(t, "voucher", applied[1].Label)
+	assert.True(t, applied[1].Input.Equal(applied[0].Output))
+	assert.Equal(t, "5.00", applied[1].Amount.Amount().Text('f', 2))
+	assert.True(t, applied[1].Output.Equal(result))
+}
+
+func TestDiscount_IsActive(t *testing.T) {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	discount := Discount{Percentage: 10, ValidFrom: &from, ValidUntil: &until}
+
+	assert.False(t, discount.IsActive(from.Add(-time.Second)))
+	assert.True(t, discount.IsActive(from))
+	assert.True(t, discount.IsActive(until.Add(-time.Second)))
+	assert.False(t, discount.IsActive(until))
+
+	assert.True(t, Discount{Percentage: 10}.IsActive(time.Time{}), "no window is always active")
+	assert.True(t, Discount{ValidFrom: &from}.IsActive(until))
+	assert.True(t, Discount{ValidUntil: &until}.IsActive(from))
+}
+
+func TestDiscounts_ApplyAt(t *testing.T) {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	discounts := Discounts{
+		{Label: "summer", Percentage: 10, ValidFrom: &from, ValidUntil: &until},
+		{Label: "always", Price: NewFromInt(5, 1, "EUR")},
+	}
+
+	result, applied, err := discounts.ApplyAt(NewFromInt(100, 1, "EUR"), StackingSequential, from)
+	require.NoError(t, err)
+	assert.Equal(t, "85.00", result.Amount().Text('f', 2))
+	assert.Len(t, applied, 2)
+
+	result, applied, err = discounts.ApplyAt(NewFromInt(100, 1, "EUR"), StackingSequential, until)
+	require.NoError(t, err)
+	assert.Equal(t, "95.00", result.Amount().Text('f', 2))
+	require.Len(t, applied, 1)
+	assert.Equal(t, "always", applied[0].Label)
+
+	data, err := json.Marshal(discounts[0])
+	require.NoError(t, err)
+	var decoded Discount
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.ValidFrom.Equal(from))
+	assert.True(t, decoded.ValidUntil.Equal(until))
+}
+
+func TestDiscount_Scan(t *testing.T) {
+	value, err := Discount{Label: "summer", Percentage: 10}.Value()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  Discount
+	}{
+		{name: "json bytes", value: value, want: Discount{Label: "summer", Percentage: 10}},
+		{name: "json string", value: string(value.([]byte)), want: Discount{Label: "summer", Percentage: 10}},
+		{name: "percent string", value: "12.5", want: Discount{BasisPoints: 1250}},
+		{name: "int", value: int64(20), want: Discount{Percentage: 20}},
+		{name: "float", value: 7.5, want: Discount{BasisPoints: 750}},
+		{name: "null", value: nil, want: Discount{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanned := Discount{Label: "old"}
+			require.NoError(t, scanned.Scan(tt.value))
+			assert.Equal(t, tt.want.Label, scanned.Label)
+			assert.Equal(t, tt.want.Percent(), scanned.Percent())
+			assert.True(t, scanned.Price.IsZero())
+		})
+	}
+
+	var scanned Discount
+	assert.Error(t, scanned.Scan(true))
+	assert.Error(t, scanned.Scan("abc"))
+	assert.Error(t, scanned.Scan(0.001))
+}
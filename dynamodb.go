@@ -0,0 +1,108 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// DynamoDBPrice holds the attributes of a price in DynamoDB, MarshalDynamoDBAttributeValue
+// stores them as map {amount: "12.34", currency: "EUR"}
+type DynamoDBPrice struct {
+	// Amount is the exact decimal amount, e.g. "12.34"
+	Amount string `dynamodbav:"amount" json:"amount"`
+	// Currency is the currency code
+	Currency string `dynamodbav:"currency" json:"currency"`
+}
+
+// ToDynamoDB returns the DynamoDB attributes of the price, infinite amounts cannot be stored
+func (p Price) ToDynamoDB() (DynamoDBPrice, error) {
+	if p.amount.IsInf() {
+		return DynamoDBPrice{}, ErrNotFinite
+	}
+	return DynamoDBPrice{
+		Amount:   p.canonicalAmount(),
+		Currency: p.currency,
+	}, nil
+}
+
+// Price returns the price of the attributes, a missing amount is read as zero
+func (d DynamoDBPrice) Price() (Price, error) {
+	if d.Amount == "" {
+		return NewZero(d.Currency), nil
+	}
+	amount, _, err := new(big.Float).Parse(d.Amount, 10)
+	if err != nil || amount.IsInf() {
+		return NewZero(d.Currency), errors.New("invalid amount " + d.Amount)
+	}
+	return NewFromBigFloat(*amount, d.Currency), nil
+}
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler interface of the AWS SDK v2,
+// Price fields are stored as map {amount: "12.34", currency: "EUR"}:
+//
+//	type Product struct {
+//		ID    string
+//		Price price.Price
+//	}
+//
+//	item, err := attributevalue.MarshalMap(product)
+func (p Price) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	d, err := p.ToDynamoDB()
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"amount":   &types.AttributeValueMemberS{Value: d.Amount},
+		"currency": &types.AttributeValueMemberS{Value: d.Currency},
+	}}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the attributevalue.Unmarshaler interface of the AWS SDK v2.
+// Besides the map of MarshalDynamoDBAttributeValue a plain number or string amount keeps the currency
+// of the receiver and NULL is read as zero price.
+func (p *Price) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	var d DynamoDBPrice
+	switch v := av.(type) {
+	case *types.AttributeValueMemberM:
+		amount, err := dynamoDBString(v.Value["amount"])
+		if err != nil {
+			return err
+		}
+		currency, err := dynamoDBString(v.Value["currency"])
+		if err != nil {
+			return err
+		}
+		d = DynamoDBPrice{Amount: amount, Currency: currency}
+	case *types.AttributeValueMemberN:
+		d = DynamoDBPrice{Amount: v.Value, Currency: p.currency}
+	case *types.AttributeValueMemberS:
+		d = DynamoDBPrice{Amount: v.Value, Currency: p.currency}
+	case *types.AttributeValueMemberNULL:
+		d = DynamoDBPrice{Currency: p.currency}
+	default:
+		return errors.New("unsupported DynamoDB attribute value for price")
+	}
+
+	parsed, err := d.Price()
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// dynamoDBString returns the value of a string or number attribute, missing attributes are empty
+func dynamoDBString(av types.AttributeValue) (string, error) {
+	switch v := av.(type) {
+	case nil, *types.AttributeValueMemberNULL:
+		return "", nil
+	case *types.AttributeValueMemberS:
+		return v.Value, nil
+	case *types.AttributeValueMemberN:
+		return v.Value, nil
+	default:
+		return "", errors.New("unsupported DynamoDB attribute value for price")
+	}
+}
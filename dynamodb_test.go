@@ -0,0 +1,86 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_ToDynamoDB(t *testing.T) {
+	d, err := NewFromInt(1234, 100, "EUR").ToDynamoDB()
+	require.NoError(t, err)
+	assert.Equal(t, DynamoDBPrice{Amount: "12.34", Currency: "EUR"}, d)
+
+	d, err = NewFromFloat(-0.1, "USD").ToDynamoDB()
+	require.NoError(t, err)
+	assert.Equal(t, DynamoDBPrice{Amount: "-0.1", Currency: "USD"}, d)
+
+	_, err = NewFromBigFloat(*new(big.Float).SetInf(false), "EUR").ToDynamoDB()
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestDynamoDBPrice_Price(t *testing.T) {
+	p, err := DynamoDBPrice{Amount: "12.34", Currency: "EUR"}.Price()
+	require.NoError(t, err)
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(p))
+
+	p, err = DynamoDBPrice{Currency: "EUR"}.Price()
+	require.NoError(t, err)
+	assert.True(t, p.IsZero())
+	assert.Equal(t, "EUR", p.Currency())
+
+	_, err = DynamoDBPrice{Amount: "abc", Currency: "EUR"}.Price()
+	assert.Error(t, err)
+	_, err = DynamoDBPrice{Amount: "Inf", Currency: "EUR"}.Price()
+	assert.Error(t, err)
+}
+
+func TestPrice_MarshalDynamoDBAttributeValue(t *testing.T) {
+	type product struct {
+		ID    string `dynamodbav:"id"`
+		Price Price  `dynamodbav:"price"`
+		Sale  *Price `dynamodbav:"sale,omitempty"`
+	}
+
+	item, err := attributevalue.MarshalMap(product{ID: "a", Price: NewFromInt(1234, 100, "EUR")})
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"amount":   &types.AttributeValueMemberS{Value: "12.34"},
+		"currency": &types.AttributeValueMemberS{Value: "EUR"},
+	}}, item["price"])
+	assert.NotContains(t, item, "sale")
+
+	var decoded product
+	require.NoError(t, attributevalue.UnmarshalMap(item, &decoded))
+	assert.True(t, decoded.Price.Equal(NewFromInt(1234, 100, "EUR")))
+	assert.Nil(t, decoded.Sale)
+
+	_, err = attributevalue.Marshal(NewFromBigFloat(*new(big.Float).SetInf(false), "EUR"))
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestPrice_UnmarshalDynamoDBAttributeValue(t *testing.T) {
+	p := NewZero("EUR")
+	require.NoError(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "9.99"}))
+	assert.True(t, p.LikelyEqual(NewFromInt(999, 100, "EUR")), "plain amounts keep the currency")
+
+	require.NoError(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberNULL{Value: true}))
+	assert.True(t, p.Equal(NewZero("EUR")))
+
+	require.NoError(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"amount":   &types.AttributeValueMemberN{Value: "5"},
+		"currency": &types.AttributeValueMemberS{Value: "USD"},
+	}}))
+	assert.True(t, p.Equal(NewFromInt(5, 1, "USD")))
+
+	assert.Error(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberBOOL{Value: true}))
+	assert.Error(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "abc"}))
+	assert.Error(t, p.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"amount": &types.AttributeValueMemberBOOL{Value: true},
+	}}))
+	assert.True(t, p.Equal(NewFromInt(5, 1, "USD")), "errors keep the receiver")
+}
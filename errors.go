@@ -0,0 +1,53 @@
+package price
+
+import (
+	"errors"
+)
+
+// Sentinel errors returned by the package, use errors.Is to check them, e.g.
+//
+//	if _, err := a.Add(b); errors.Is(err, price.ErrCurrencyMismatch) {
+//		// convert b to the currency of a first
+//	}
+//
+// Errors with more details wrap the sentinel, so their message may differ from the sentinel message
+var (
+	// ErrCurrencyMismatch is returned for calculations and comparisons of prices in different currencies
+	ErrCurrencyMismatch = errors.New("cannot calculate prices in different currencies")
+	// ErrEmptyPriceList is returned for aggregations of an empty list of prices
+	ErrEmptyPriceList = errors.New("no price given")
+	// ErrInvalidSplitCount is returned if a price should be split in zero or less parts
+	ErrInvalidSplitCount = errors.New("split must be higher than zero")
+	// ErrNotFinite is returned for infinite amounts or factors where finite ones are required
+	ErrNotFinite = errors.New("amount must be a finite number")
+	// ErrDivisionByZero is returned for divisions by a zero price
+	ErrDivisionByZero = errors.New("cannot divide by zero")
+	// ErrUnknownRoundingMode is returned for rounding modes that are not defined
+	ErrUnknownRoundingMode = errors.New("unknown rounding mode")
+	// ErrUnknownCurrency is returned for currencies that are not registered
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrInvalidFormat is returned for text that is not a valid formatted price, see ParseFormatted
+	ErrInvalidFormat = errors.New("invalid formatted price")
+	// ErrInvalidBounds is returned if a lower bound is higher than the upper bound, e.g. by Clamp
+	ErrInvalidBounds = errors.New("lower bound must not be higher than upper bound")
+)
+
+// detailedError is an error with its own message that matches a sentinel error with errors.Is
+type detailedError struct {
+	msg      string
+	sentinel error
+}
+
+// newDetailedError returns an error with the message msg wrapping the sentinel
+func newDetailedError(sentinel error, msg string) error {
+	return &detailedError{msg: msg, sentinel: sentinel}
+}
+
+func (e *detailedError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the sentinel error
+func (e *detailedError) Unwrap() error {
+	return e.sentinel
+}
@@ -0,0 +1,84 @@
+package price_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	eur := price.NewFromInt(245, 100, "EUR")
+	usd := price.NewFromInt(100, 100, "USD")
+	inf := price.NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+
+	_, err := eur.Add(usd)
+	assert.True(t, errors.Is(err, price.ErrCurrencyMismatch))
+	_, err = eur.Cmp(usd)
+	assert.True(t, errors.Is(err, price.ErrCurrencyMismatch))
+	assert.EqualError(t, err, "cannot compare prices in different currencies")
+	_, err = price.NewPriceRange(eur, usd)
+	assert.True(t, errors.Is(err, price.ErrCurrencyMismatch))
+
+	_, err = price.SumAll()
+	assert.True(t, errors.Is(err, price.ErrEmptyPriceList))
+	_, err = price.Prices{}.Average()
+	assert.True(t, errors.Is(err, price.ErrEmptyPriceList))
+
+	_, err = eur.SplitInPayables(0)
+	assert.True(t, errors.Is(err, price.ErrInvalidSplitCount))
+	_, _, err = eur.DivMod(0)
+	assert.True(t, errors.Is(err, price.ErrInvalidSplitCount))
+	assert.EqualError(t, err, "qty must be higher than zero")
+
+	_, err = price.NewFromFloatChecked(1/zero(), "EUR")
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, _, err = inf.DivMod(2)
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = inf.MinorUnits()
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = inf.StripeAmount()
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = inf.ToProtoMoney()
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = inf.RoundToEnding(99)
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = eur.SnapToGrid(inf, price.RoundingModeHalfUp)
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = inf.SnapToGrid(price.NewFromInt(25, 100, "EUR"), price.RoundingModeHalfUp)
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	assert.EqualError(t, err, "cannot snap infinite amounts")
+	rate, err := price.NewExchangeRate("EUR", "USD", *big.NewFloat(1.1), time.Now())
+	require.NoError(t, err)
+	_, err = inf.ConvertTo(rate, price.RoundingModeHalfUp)
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+	_, err = price.Prices{eur, inf}.WeightedAverage([]int64{1, 1})
+	assert.True(t, errors.Is(err, price.ErrNotFinite))
+
+	_, err = price.SplitPlanner{Methods: []price.PaymentMethod{{Type: price.ChargeTypeGiftCard, Limit: &usd}}}.Plan(eur)
+	assert.True(t, errors.Is(err, price.ErrCurrencyMismatch))
+
+	_, err = eur.Mod(price.NewZero("EUR"))
+	assert.True(t, errors.Is(err, price.ErrDivisionByZero))
+
+	_, err = price.ParseRoundingMode("up")
+	assert.True(t, errors.Is(err, price.ErrUnknownRoundingMode))
+	_, err = price.ParseCurrency("XYZ")
+	assert.True(t, errors.Is(err, price.ErrUnknownCurrency))
+
+	_, err = eur.Clamp(price.NewFromInt(5, 1, "EUR"), price.NewFromInt(1, 1, "EUR"))
+	assert.True(t, errors.Is(err, price.ErrInvalidBounds))
+	_, err = eur.Between(price.NewFromInt(5, 1, "EUR"), price.NewFromInt(1, 1, "EUR"), true)
+	assert.True(t, errors.Is(err, price.ErrInvalidBounds))
+	_, err = price.NewPriceRange(price.NewFromInt(5, 1, "EUR"), price.NewFromInt(1, 1, "EUR"))
+	assert.True(t, errors.Is(err, price.ErrInvalidBounds))
+}
+
+func zero() float64 {
+	return 0
+}
@@ -0,0 +1,233 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+)
+
+// ExactPrice is an immutable price with an exact rational amount.
+// Unlike Price it represents decimals like 0.1 exactly and does not accumulate errors in long Add/Discount chains.
+// Use Price.Exact and ExactPrice.Price to switch between both types, e.g. to calculate a pipeline exactly and round once at the end
+type ExactPrice struct {
+	amount   big.Rat
+	currency string
+}
+
+// exactFloatPrec is the precision of the big.Float used when an ExactPrice is converted to a Price
+const exactFloatPrec = 128
+
+// NewExactFromInt use to set money by smallest payable unit - e.g. NewExactFromInt(245, 100, "EUR") for 2.45 EUR
+func NewExactFromInt(amount int64, precision int, currency string) ExactPrice {
+	if precision == 0 {
+		return NewExactZero(currency)
+	}
+	return ExactPrice{
+		amount:   *big.NewRat(amount, int64(precision)),
+		currency: currency,
+	}
+}
+
+// NewExactFromRat - factory method, the amount is copied
+func NewExactFromRat(amount *big.Rat, currency string) ExactPrice {
+	e := ExactPrice{currency: currency}
+	if amount != nil {
+		e.amount.Set(amount)
+	}
+	return e
+}
+
+// NewExactZero Zero price
+func NewExactZero(currency string) ExactPrice {
+	return ExactPrice{currency: currency}
+}
+
+// ParseExact parses a decimal ("0.1") or fraction ("1/3") amount
+func ParseExact(amount string, currency string) (ExactPrice, error) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return NewExactZero(currency), errors.New("invalid amount " + amount)
+	}
+	return NewExactFromRat(r, currency), nil
+}
+
+// Exact returns the price as ExactPrice. The amount is taken from its shortest decimal representation,
+// so NewFromFloat(0.1, "EUR").Exact() is exactly 0.1 and not the binary approximation of 0.1
+func (p Price) Exact() (ExactPrice, error) {
+	if p.amount.IsInf() {
+		return NewExactZero(p.currency), ErrNotFinite
+	}
+	return ParseExact(p.canonicalAmount(), p.currency)
+}
+
+// Price returns the amount as Price, the conversion to big.Float is only exact for binary fractions
+func (e ExactPrice) Price() Price {
+	return NewFromBigFloat(*new(big.Float).SetPrec(exactFloatPrec).SetRat(&e.amount), e.currency)
+}
+
+// GetPayable rounds the exact amount once with the rounding configuration of the currency, see Price.GetPayable
+func (e ExactPrice) GetPayable() Price {
+	mode, precision := Price{currency: e.currency}.payableRoundingPrecision()
+	return e.GetPayableByRoundingMode(mode, precision)
+}
+
+// GetPayableByRoundingMode rounds the exact amount with the given mode and precision, see Price.GetPayableByRoundingMode
+func (e ExactPrice) GetPayableByRoundingMode(mode RoundingMode, precision int) Price {
+	if precision <= 0 {
+		return NewZero(e.currency)
+	}
+	scaled := new(big.Rat).Mul(&e.amount, new(big.Rat).SetInt64(int64(precision)))
+	units := roundRat(scaled, mode)
+	return Price{currency: e.currency}.withRat(new(big.Rat).SetFrac(units, big.NewInt(int64(precision))))
+}
+
+// Currency returns currency
+func (e ExactPrice) Currency() string {
+	return e.currency
+}
+
+// Rat returns a copy of the exact amount
+func (e ExactPrice) Rat() *big.Rat {
+	return new(big.Rat).Set(&e.amount)
+}
+
+// Add the given price to the current price and returns a new price
+func (e ExactPrice) Add(add ExactPrice) (ExactPrice, error) {
+	currency, err := e.currencyGuard(add)
+	if err != nil {
+		return NewExactZero(e.currency), err
+	}
+	return NewExactFromRat(new(big.Rat).Add(&e.amount, &add.amount), currency), nil
+}
+
+// Sub the given price from the current price and returns a new price
+func (e ExactPrice) Sub(sub ExactPrice) (ExactPrice, error) {
+	currency, err := e.currencyGuard(sub)
+	if err != nil {
+		return NewExactZero(e.currency), err
+	}
+	return NewExactFromRat(new(big.Rat).Sub(&e.amount, &sub.amount), currency), nil
+}
+
+// currencyGuard works like Price.currencyGuard and returns the currency of the result
+func (e ExactPrice) currencyGuard(check ExactPrice) (string, error) {
+	switch {
+	case e.currency == check.currency:
+		return check.currency, nil
+	case IsStrictCurrencyGuard():
+		return "", ErrCurrencyMismatch
+	case e.IsZero():
+		return check.currency, nil
+	case check.IsZero():
+		return e.currency, nil
+	}
+	return "", ErrCurrencyMismatch
+}
+
+// Multiply returns a new price with the amount multiplied by qty
+func (e ExactPrice) Multiply(qty int) ExactPrice {
+	return e.MultiplyRat(new(big.Rat).SetInt64(int64(qty)))
+}
+
+// MultiplyRat returns a new price with the amount multiplied by r, nil is treated as zero
+func (e ExactPrice) MultiplyRat(r *big.Rat) ExactPrice {
+	if r == nil {
+		return NewExactZero(e.currency)
+	}
+	return NewExactFromRat(new(big.Rat).Mul(&e.amount, r), e.currency)
+}
+
+// Divided returns a new price with the amount divided by qty, qty 0 returns zero like Price.Divided
+func (e ExactPrice) Divided(qty int) ExactPrice {
+	if qty == 0 {
+		return NewExactZero(e.currency)
+	}
+	return NewExactFromRat(new(big.Rat).Quo(&e.amount, new(big.Rat).SetInt64(int64(qty))), e.currency)
+}
+
+// DiscountedBy returns new price reduced by given percent
+func (e ExactPrice) DiscountedBy(percent Percent) ExactPrice {
+	factor := new(big.Rat).Sub(big.NewRat(1, 1), new(big.Rat).Quo(&percent.rat, big.NewRat(100, 1)))
+	return e.MultiplyRat(factor)
+}
+
+// TaxedBy returns new price increased by given percent
+func (e ExactPrice) TaxedBy(percent Percent) ExactPrice {
+	factor := new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Quo(&percent.rat, big.NewRat(100, 1)))
+	return e.MultiplyRat(factor)
+}
+
+// Inverse returns the price multiplied with -1
+func (e ExactPrice) Inverse() ExactPrice {
+	return NewExactFromRat(new(big.Rat).Neg(&e.amount), e.currency)
+}
+
+// Equal compares the prices exactly
+func (e ExactPrice) Equal(cmp ExactPrice) bool {
+	return e.currency == cmp.currency && e.amount.Cmp(&cmp.amount) == 0
+}
+
+// Cmp compares the amounts like big.Rat.Cmp, prices in different currencies cannot be compared
+func (e ExactPrice) Cmp(cmp ExactPrice) (int, error) {
+	if e.currency != cmp.currency {
+		return 0, newDetailedError(ErrCurrencyMismatch, "cannot compare prices in different currencies")
+	}
+	return e.amount.Cmp(&cmp.amount), nil
+}
+
+// IsZero returns true if the amount is zero
+func (e ExactPrice) IsZero() bool {
+	return e.amount.Sign() == 0
+}
+
+// IsNegative returns true if the amount is lower than zero
+func (e ExactPrice) IsNegative() bool {
+	return e.amount.Sign() < 0
+}
+
+// IsPositive returns true if the amount is higher than zero
+func (e ExactPrice) IsPositive() bool {
+	return e.amount.Sign() > 0
+}
+
+func (e ExactPrice) String() string {
+	bytes, _ := e.MarshalText()
+	return string(bytes)
+}
+
+// MarshalText uses the JSON format of Price. Repeating decimals are written as fraction, e.g. "1/3",
+// which can only be read by ExactPrice
+func (e ExactPrice) MarshalText() ([]byte, error) {
+	amount, exact := ratDecimalString(&e.amount, 0)
+	if !exact {
+		amount = e.amount.String()
+	}
+	return json.Marshal(&priceJSON{
+		Amount:   amount,
+		Currency: e.currency,
+	})
+}
+
+// UnmarshalText reads the JSON format of Price, amounts may also be fractions like "1/3"
+func (e *ExactPrice) UnmarshalText(b []byte) error {
+	pj := &priceJSON{}
+	if err := json.Unmarshal(b, pj); err != nil {
+		return err
+	}
+	parsed, err := ParseExact(pj.Amount, pj.Currency)
+	if err != nil {
+		return err
+	}
+	*e = parsed
+	return nil
+}
+
+// MarshalJSON implements interface required by json marshal
+func (e ExactPrice) MarshalJSON() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// UnmarshalJSON implements encode Unmarshaler
+func (e *ExactPrice) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalText(data)
+}
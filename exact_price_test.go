@@ -0,0 +1,78 @@
+package price_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestExactPrice_Add(t *testing.T) {
+	tenCents, err := price.ParseExact("0.1", "EUR")
+	require.NoError(t, err)
+
+	sum := price.NewExactZero("EUR")
+	for i := 0; i < 10; i++ {
+		sum, err = sum.Add(tenCents)
+		require.NoError(t, err)
+	}
+	assert.True(t, sum.Equal(price.NewExactFromInt(1, 1, "EUR")))
+
+	_, err = sum.Add(price.NewExactFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestExactPrice_DiscountChain(t *testing.T) {
+	p := price.NewExactFromInt(10000, 100, "EUR")
+	for i := 0; i < 3; i++ {
+		p = p.DiscountedBy(price.NewPercent(10))
+	}
+	assert.Equal(t, "729/10", p.Rat().String())
+	assert.Equal(t, "72.9", p.GetPayable().Amount().Text('f', -1))
+
+	third := price.NewExactFromInt(100, 1, "EUR").Divided(3)
+	back := third.Multiply(3)
+	assert.True(t, back.Equal(price.NewExactFromInt(100, 1, "EUR")))
+	assert.Equal(t, "33.33", third.GetPayable().Amount().Text('f', 2))
+	assert.Equal(t, "33.34", third.GetPayableByRoundingMode(price.RoundingModeCeil, 100).Amount().Text('f', 2))
+}
+
+func TestExactPrice_ConversionToPrice(t *testing.T) {
+	exact, err := price.NewFromFloat(0.1, "EUR").Exact()
+	require.NoError(t, err)
+	assert.Equal(t, "1/10", exact.Rat().String())
+
+	back, err := exact.Price().Exact()
+	require.NoError(t, err)
+	assert.True(t, exact.Equal(back))
+
+	_, err = price.NewFromBigFloat(*new(big.Float).SetInf(false), "EUR").Exact()
+	assert.Error(t, err)
+}
+
+func TestExactPrice_JSON(t *testing.T) {
+	p, err := price.ParseExact("1/3", "EUR")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"1/3","currency":"EUR"}`, string(data))
+
+	data, err = json.Marshal(price.NewExactFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.34","currency":"EUR"}`, string(data))
+
+	var fromPrice price.Price
+	require.NoError(t, json.Unmarshal(data, &fromPrice))
+	assert.Equal(t, "12.34", fromPrice.Amount().Text('f', 2))
+
+	var parsed price.ExactPrice
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"1/3","currency":"EUR"}`), &parsed))
+	assert.True(t, parsed.Equal(p))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"abc","currency":"EUR"}`), &parsed))
+}
@@ -0,0 +1,145 @@
+package price_test
+
+import (
+	"fmt"
+
+	price "github.com/maohieng/go-price"
+)
+
+func ExampleNewFromInt() {
+	// 2.45 EUR
+	p := price.NewFromInt(245, 100, "EUR")
+
+	fmt.Println(p.Amount().Text('f', 2), p.Currency())
+	// Output: 2.45 EUR
+}
+
+func ExamplePrice_GetPayable() {
+	p := price.NewFromFloat(12.34567, "EUR")
+
+	fmt.Println(p.GetPayable().Amount().Text('f', 2))
+	// miles and points are rounded down to whole numbers
+	fmt.Println(price.NewFromFloat(99.9, "points").GetPayable().Amount().Text('f', 0))
+	// Output:
+	// 12.35
+	// 99
+}
+
+func ExamplePrice_GetPayableByRoundingMode() {
+	p := price.NewFromFloat(-1.115, "EUR")
+
+	for _, mode := range []price.RoundingMode{price.RoundingModeFloor, price.RoundingModeCeil, price.RoundingModeHalfUp, price.RoundingModeHalfDown} {
+		fmt.Println(mode, p.GetPayableByRoundingMode(mode, 100).Amount().Text('f', 2))
+	}
+	// Output:
+	// floor -1.12
+	// ceil -1.11
+	// halfup -1.12
+	// halfdown -1.11
+}
+
+func ExamplePrice_SplitInPayables() {
+	p := price.NewFromFloat(12.456, "EUR")
+
+	// the payable 12.46 cannot be split in 6 equal payable parts,
+	// the remaining cents are distributed to the first parts
+	parts, _ := p.SplitInPayables(6)
+	for _, part := range parts {
+		fmt.Println(part.Amount().Text('f', 2))
+	}
+	// Output:
+	// 2.08
+	// 2.08
+	// 2.08
+	// 2.08
+	// 2.07
+	// 2.07
+}
+
+func ExamplePrice_Discounted() {
+	p := price.NewFromInt(1245, 100, "EUR")
+
+	fmt.Println(p.Discounted(10).GetPayable().Amount().Text('f', 2))
+	// Output: 11.21
+}
+
+func ExamplePrice_TaxFromGross() {
+	gross := price.NewFromInt(11900, 100, "EUR")
+
+	tax := gross.TaxFromGrossBy(price.NewPercent(19))
+	fmt.Println(tax.GetPayable().Amount().Text('f', 2))
+	// Output: 19.00
+}
+
+func ExamplePercent_Of() {
+	pct, _ := price.ParsePercent("12.5%")
+
+	fmt.Println(pct.Of(price.NewFromInt(80, 1, "EUR")).Amount().Text('f', 2))
+	// Output: 10.00
+}
+
+func ExampleDiscount_Apply() {
+	maxAmount := price.NewFromInt(5, 1, "EUR")
+	discount := price.Discount{Label: "summer sale", Percentage: 20, MaxAmount: &maxAmount}
+
+	// 20% of 12.00 EUR are 2.40 EUR, of 40.00 EUR the discount is capped at 5.00 EUR
+	for _, p := range []price.Price{price.NewFromInt(1200, 100, "EUR"), price.NewFromInt(4000, 100, "EUR")} {
+		discounted, _ := discount.Apply(p)
+		fmt.Println(discounted.GetPayable().Amount().Text('f', 2))
+	}
+	// Output:
+	// 9.60
+	// 35.00
+}
+
+func ExampleDiscounts_Apply() {
+	discounts := price.Discounts{
+		{Label: "member", Percentage: 10},
+		{Label: "voucher", Price: price.NewFromInt(5, 1, "EUR")},
+	}
+
+	result, applied, _ := discounts.Apply(price.NewFromInt(100, 1, "EUR"), price.StackingSequential)
+	for _, a := range applied {
+		fmt.Println(a.Label, a.Amount.Amount().Text('f', 2))
+	}
+	fmt.Println(result.GetPayable().Amount().Text('f', 2))
+	// Output:
+	// member 10.00
+	// voucher 5.00
+	// 85.00
+}
+
+func ExampleCharges_Add() {
+	giftCard := price.Charge{
+		Type:      price.ChargeTypeGiftCard,
+		Reference: "GC-1",
+		Price:     price.NewFromInt(20, 1, "EUR"),
+		Value:     price.NewFromInt(20, 1, "EUR"),
+	}
+	main := price.Charge{
+		Type:  price.ChargeTypeMain,
+		Price: price.NewFromInt(1550, 100, "EUR"),
+		Value: price.NewFromInt(1550, 100, "EUR"),
+	}
+
+	cart := price.Charges{}.AddCharge(giftCard)
+	payment := price.Charges{}.AddCharge(main).AddCharge(main)
+
+	// charges with the same qualifier are summed up
+	total := cart.Add(payment)
+	fmt.Println(total.GetByTypeForced(price.ChargeTypeMain).Price.Amount().Text('f', 2))
+	fmt.Println(total.GetByTypeForced(price.ChargeTypeGiftCard).Price.Amount().Text('f', 2))
+	// Output:
+	// 31.00
+	// 20.00
+}
+
+func ExampleContext() {
+	ctx := price.NewPayableContext("EUR")
+
+	// every intermediate result is rounded with the same policy
+	total, _ := ctx.Sum(price.NewFromFloat(0.333, "EUR"), price.NewFromFloat(0.333, "EUR"), price.NewFromFloat(0.333, "EUR"))
+	gross, _ := ctx.Taxed(total, price.NewPercent(19))
+	fmt.Println(total.Amount().Text('f', 2), gross.Amount().Text('f', 2))
+	// Output: 0.99 1.18
+}
@@ -0,0 +1,150 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// ExchangeRate is an immutable exchange rate between two currencies,
+// one unit of From is worth Rate units of To
+type ExchangeRate struct {
+	from      string
+	to        string
+	rate      big.Rat
+	timestamp time.Time
+}
+
+// NewExchangeRate returns a new exchange rate, the rate must be a finite number higher than zero
+func NewExchangeRate(from, to string, rate big.Float, timestamp time.Time) (ExchangeRate, error) {
+	if rate.Sign() <= 0 || rate.IsInf() {
+		return ExchangeRate{}, errors.New("rate must be a finite number higher than zero")
+	}
+	r, _ := rate.Rat(nil)
+	return NewExchangeRateFromRat(from, to, r, timestamp)
+}
+
+// NewExchangeRateFromRat returns a new exchange rate from an exact rational rate
+func NewExchangeRateFromRat(from, to string, rate *big.Rat, timestamp time.Time) (ExchangeRate, error) {
+	if from == "" || to == "" {
+		return ExchangeRate{}, errors.New("currencies must not be empty")
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return ExchangeRate{}, errors.New("rate must be higher than zero")
+	}
+	if from == to && rate.Cmp(big.NewRat(1, 1)) != 0 {
+		return ExchangeRate{}, errors.New("rate between the same currency must be 1")
+	}
+	e := ExchangeRate{from: from, to: to, timestamp: timestamp}
+	e.rate.Set(rate)
+	return e, nil
+}
+
+// From returns the source currency
+func (e ExchangeRate) From() string {
+	return e.from
+}
+
+// To returns the target currency
+func (e ExchangeRate) To() string {
+	return e.to
+}
+
+// Timestamp returns the time the rate was quoted
+func (e ExchangeRate) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// Rate returns the rate as big.Float
+func (e ExchangeRate) Rate() big.Float {
+	return *new(big.Float).SetPrec(64).SetRat(&e.rate)
+}
+
+// Rat returns a copy of the exact rate
+func (e ExchangeRate) Rat() *big.Rat {
+	return new(big.Rat).Set(&e.rate)
+}
+
+// IsZero returns true for the zero value, which is not a valid rate
+func (e ExchangeRate) IsZero() bool {
+	return e.rate.Sign() == 0
+}
+
+// Invert returns the rate in the opposite direction
+func (e ExchangeRate) Invert() ExchangeRate {
+	inverted := ExchangeRate{from: e.to, to: e.from, timestamp: e.timestamp}
+	if !e.IsZero() {
+		inverted.rate.Inv(&e.rate)
+	}
+	return inverted
+}
+
+// Cross combines two rates sharing one currency into a rate between the other two currencies,
+// e.g. EUR->USD crossed with USD->CHF (or CHF->USD) results in EUR->CHF.
+// The timestamp of the result is the older one of both rates.
+func (e ExchangeRate) Cross(other ExchangeRate) (ExchangeRate, error) {
+	if e.IsZero() || other.IsZero() {
+		return ExchangeRate{}, errors.New("cannot cross an empty exchange rate")
+	}
+
+	first, second := e, other
+	switch {
+	case first.to == second.from:
+	case first.to == second.to:
+		second = second.Invert()
+	case first.from == second.from:
+		first = first.Invert()
+	case first.from == second.to:
+		first, second = first.Invert(), second.Invert()
+	default:
+		return ExchangeRate{}, errors.New("exchange rates do not share a currency")
+	}
+	if first.from == second.to {
+		return ExchangeRate{}, errors.New("exchange rates are between the same currencies")
+	}
+
+	timestamp := first.timestamp
+	if second.timestamp.Before(timestamp) {
+		timestamp = second.timestamp
+	}
+	return NewExchangeRateFromRat(first.from, second.to, new(big.Rat).Mul(&first.rate, &second.rate), timestamp)
+}
+
+// Apply converts p with the rate, the result is not rounded
+func (e ExchangeRate) Apply(p Price) (Price, error) {
+	if e.IsZero() {
+		return Price{}, errors.New("cannot apply an empty exchange rate")
+	}
+	if p.Currency() != e.from {
+		return Price{}, newDetailedError(ErrCurrencyMismatch, "price currency "+p.Currency()+" does not match exchange rate currency "+e.from)
+	}
+	converted := p.mulRat(&e.rate)
+	converted.currency = e.to
+	return converted, nil
+}
+
+// String returns the rate like "EUR/USD 1.25"
+func (e ExchangeRate) String() string {
+	return e.from + "/" + e.to + " " + e.rate.FloatString(6)
+}
+
+// ConvertTo converts the price with the exchange rate and rounds the result with mode to the payable precision of the target currency.
+// The conversion uses exact rational math so no intermediate rounding happens.
+func (p Price) ConvertTo(rate ExchangeRate, mode RoundingMode) (Price, error) {
+	if err := mode.Validate(); err != nil {
+		return Price{}, err
+	}
+	converted, err := rate.Apply(p)
+	if err != nil {
+		return Price{}, err
+	}
+	if converted.amount.IsInf() {
+		return Price{}, newDetailedError(ErrNotFinite, "cannot convert an infinite amount")
+	}
+
+	_, precision := converted.payableRoundingPrecision()
+	amount, _ := converted.amount.Rat(nil)
+	amount.Mul(amount, big.NewRat(int64(precision), 1))
+	units := roundRat(amount, mode)
+	return converted.withRat(new(big.Rat).SetFrac(units, big.NewInt(int64(precision)))), nil
+}
@@ -0,0 +1,129 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	at := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	rate, err := NewExchangeRate("EUR", "USD", *big.NewFloat(1.25), at)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", rate.From())
+	assert.Equal(t, "USD", rate.To())
+	assert.Equal(t, at, rate.Timestamp())
+	r := rate.Rate()
+	assert.Equal(t, "1.25", r.Text('f', -1))
+	assert.Equal(t, "EUR/USD 1.250000", rate.String())
+
+	_, err = NewExchangeRate("EUR", "USD", *big.NewFloat(0), at)
+	assert.Error(t, err)
+	_, err = NewExchangeRate("EUR", "USD", *big.NewFloat(-1), at)
+	assert.Error(t, err)
+	_, err = NewExchangeRate("", "USD", *big.NewFloat(1), at)
+	assert.Error(t, err)
+	_, err = NewExchangeRate("EUR", "EUR", *big.NewFloat(2), at)
+	assert.Error(t, err)
+}
+
+func TestExchangeRate_Invert(t *testing.T) {
+	rate, err := NewExchangeRateFromRat("EUR", "USD", big.NewRat(5, 4), time.Time{})
+	require.NoError(t, err)
+
+	inverted := rate.Invert()
+	assert.Equal(t, "USD", inverted.From())
+	assert.Equal(t, "EUR", inverted.To())
+	assert.Equal(t, "4/5", inverted.Rat().String())
+	assert.Equal(t, "5/4", rate.Rat().String(), "original is not changed")
+}
+
+func TestExchangeRate_Cross(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	eurUsd, _ := NewExchangeRateFromRat("EUR", "USD", big.NewRat(5, 4), newer)
+	usdChf, _ := NewExchangeRateFromRat("USD", "CHF", big.NewRat(4, 5), older)
+
+	tests := []struct {
+		name        string
+		first       ExchangeRate
+		second      ExchangeRate
+		wantFrom    string
+		wantTo      string
+		wantRateRat string
+	}{
+		{name: "chained", first: eurUsd, second: usdChf, wantFrom: "EUR", wantTo: "CHF", wantRateRat: "1"},
+		{name: "common target", first: eurUsd, second: usdChf.Invert(), wantFrom: "EUR", wantTo: "CHF", wantRateRat: "1"},
+		{name: "common source", first: eurUsd.Invert(), second: usdChf, wantFrom: "EUR", wantTo: "CHF", wantRateRat: "1"},
+		{name: "reversed", first: usdChf, second: eurUsd, wantFrom: "CHF", wantTo: "EUR", wantRateRat: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crossed, err := tt.first.Cross(tt.second)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFrom, crossed.From())
+			assert.Equal(t, tt.wantTo, crossed.To())
+			assert.Equal(t, tt.wantRateRat, crossed.Rat().RatString())
+			assert.Equal(t, older, crossed.Timestamp())
+		})
+	}
+
+	gbpJpy, _ := NewExchangeRateFromRat("GBP", "JPY", big.NewRat(180, 1), older)
+	_, err := eurUsd.Cross(gbpJpy)
+	assert.Error(t, err)
+
+	_, err = eurUsd.Cross(eurUsd.Invert())
+	assert.Error(t, err, "crossing with the inverted rate results in a rate between the same currency")
+}
+
+func TestExchangeRate_Apply(t *testing.T) {
+	rate, _ := NewExchangeRate("EUR", "USD", *big.NewFloat(1.25), time.Time{})
+
+	converted, err := rate.Apply(NewFromInt(10, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "USD", converted.Currency())
+	assert.Equal(t, "12.5", converted.Amount().Text('f', -1))
+
+	_, err = rate.Apply(NewFromInt(10, 1, "USD"))
+	assert.Error(t, err)
+
+	_, err = ExchangeRate{}.Apply(NewFromInt(10, 1, "EUR"))
+	assert.Error(t, err)
+}
+
+func TestPrice_ConvertTo(t *testing.T) {
+	eurUsd, _ := NewExchangeRateFromRat("EUR", "USD", big.NewRat(11, 10), time.Time{})
+	eurJpy, _ := NewExchangeRateFromRat("EUR", "JPY", big.NewRat(1605, 10), time.Time{})
+
+	tests := []struct {
+		name  string
+		price Price
+		rate  ExchangeRate
+		mode  RoundingMode
+		want  string
+	}{
+		{name: "half up", price: NewFromInt(1005, 100, "EUR"), rate: eurUsd, mode: RoundingModeHalfUp, want: "11.06"},
+		{name: "half even", price: NewFromInt(1005, 100, "EUR"), rate: eurUsd, mode: RoundingModeHalfEven, want: "11.06"},
+		{name: "floor", price: NewFromInt(1005, 100, "EUR"), rate: eurUsd, mode: RoundingModeFloor, want: "11.05"},
+		{name: "zero decimal target", price: NewFromInt(1, 1, "EUR"), rate: eurJpy, mode: RoundingModeHalfUp, want: "161"},
+		{name: "zero decimal target half even", price: NewFromInt(1, 1, "EUR"), rate: eurJpy, mode: RoundingModeHalfEven, want: "160"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := tt.price.ConvertTo(tt.rate, tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.rate.To(), converted.Currency())
+			assert.Equal(t, tt.want, converted.Amount().Text('f', -1))
+			assert.True(t, converted.IsPayable())
+		})
+	}
+
+	_, err := NewFromInt(1, 1, "USD").ConvertTo(eurUsd, RoundingModeHalfUp)
+	assert.Error(t, err, "source currency must match")
+
+	_, err = NewFromInt(1, 1, "EUR").ConvertTo(eurUsd, RoundingMode("unknown"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+// Package finance provides financial calculations based on price.Price,
+// all resulting amounts are payable prices.
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	price "github.com/maohieng/go-price"
+)
+
+type (
+	// Installment is a single period of an amortization schedule
+	Installment struct {
+		// Period starting with 1
+		Period int
+		// Payment is the total amount paid in this period
+		Payment price.Price
+		// Principal is the part of the payment reducing the balance
+		Principal price.Price
+		// Interest is the part of the payment paying interest
+		Interest price.Price
+		// Balance is the remaining balance after this period
+		Balance price.Price
+	}
+
+	// Schedule is an amortization schedule
+	Schedule struct {
+		Installments []Installment
+		// TotalPayment is the sum of all payments
+		TotalPayment price.Price
+		// TotalInterest is the sum of all interest payments
+		TotalInterest price.Price
+	}
+)
+
+// MaxPeriods is the highest number of periods accepted by Amortize, e.g. 100 years of monthly payments.
+// The payment is computed with exact rational math, so its size grows with the number of periods
+const MaxPeriods = 1200
+
+// Amortize computes the schedule of an annuity loan with equal payments.
+// rate is the interest rate per period (e.g. 0.5% per month) and periods the number of payments, at most MaxPeriods.
+// The payment and the interest of each period are rounded to payable amounts, the last payment settles the remaining balance.
+func Amortize(principal price.Price, rate price.Percent, periods int) (Schedule, error) {
+	if periods <= 0 {
+		return Schedule{}, errors.New("periods must be higher than zero")
+	}
+	if periods > MaxPeriods {
+		return Schedule{}, errors.New("periods must not exceed " + strconv.Itoa(MaxPeriods))
+	}
+	if principal.IsNegative() {
+		return Schedule{}, errors.New("principal must not be negative")
+	}
+	if principal.Amount().IsInf() {
+		return Schedule{}, fmt.Errorf("principal must be finite: %w", price.ErrNotFinite)
+	}
+	if rate.Rat().Sign() < 0 {
+		return Schedule{}, errors.New("rate must not be negative")
+	}
+
+	currency := principal.Currency()
+	payment := annuityPayment(principal, rate, periods)
+
+	schedule := Schedule{
+		Installments:  make([]Installment, 0, periods),
+		TotalPayment:  price.NewZero(currency),
+		TotalInterest: price.NewZero(currency),
+	}
+	balance := principal.GetPayable()
+	for period := 1; period <= periods; period++ {
+		interest := rate.Of(balance).GetPayable()
+		principalPart, err := payment.Sub(interest)
+		if err != nil {
+			return Schedule{}, err
+		}
+		// the last payment settles the balance, so the schedule has no rounding leftovers
+		if period == periods || principalPart.IsGreaterThen(balance) {
+			principalPart = balance
+		}
+
+		paid, err := principalPart.Add(interest)
+		if err != nil {
+			return Schedule{}, err
+		}
+		balance, err = balance.Sub(principalPart)
+		if err != nil {
+			return Schedule{}, err
+		}
+
+		schedule.Installments = append(schedule.Installments, Installment{
+			Period:    period,
+			Payment:   paid.GetPayable(),
+			Principal: principalPart.GetPayable(),
+			Interest:  interest,
+			Balance:   balance.GetPayable(),
+		})
+		schedule.TotalPayment = schedule.TotalPayment.ForceAdd(paid).GetPayable()
+		schedule.TotalInterest = schedule.TotalInterest.ForceAdd(interest).GetPayable()
+	}
+
+	return schedule, nil
+}
+
+// annuityPayment returns the payable payment principal * r / (1 - (1+r)^-n)
+func annuityPayment(principal price.Price, rate price.Percent, periods int) price.Price {
+	amount, _ := principal.GetPayable().Amount().Rat(nil)
+	r := new(big.Rat).Quo(rate.Rat(), big.NewRat(100, 1))
+
+	var payment *big.Rat
+	if r.Sign() == 0 {
+		payment = new(big.Rat).Quo(amount, big.NewRat(int64(periods), 1))
+	} else {
+		growth := big.NewRat(1, 1)
+		onePlusR := new(big.Rat).Add(big.NewRat(1, 1), r)
+		for i := 0; i < periods; i++ {
+			growth.Mul(growth, onePlusR)
+		}
+		// principal * r * (1+r)^n / ((1+r)^n - 1)
+		payment = new(big.Rat).Mul(amount, r)
+		payment.Mul(payment, growth)
+		payment.Quo(payment, new(big.Rat).Sub(growth, big.NewRat(1, 1)))
+	}
+
+	return price.NewFromBigFloat(*new(big.Float).SetPrec(128).SetRat(payment), principal.Currency()).GetPayable()
+}
@@ -0,0 +1,74 @@
+package finance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	price "github.com/maohieng/go-price"
+)
+
+func TestAmortize(t *testing.T) {
+	// 10000 EUR, 0.5% per month, 12 months
+	schedule, err := Amortize(price.NewFromInt(10000, 1, "EUR"), price.NewPercentFromFloat(0.5), 12)
+	require.NoError(t, err)
+	require.Len(t, schedule.Installments, 12)
+
+	first := schedule.Installments[0]
+	assert.Equal(t, 1, first.Period)
+	assert.Equal(t, "860.66", first.Payment.Amount().Text('f', 2))
+	assert.Equal(t, "50.00", first.Interest.Amount().Text('f', 2))
+	assert.Equal(t, "810.66", first.Principal.Amount().Text('f', 2))
+	assert.Equal(t, "9189.34", first.Balance.Amount().Text('f', 2))
+
+	last := schedule.Installments[11]
+	assert.True(t, last.Balance.IsZero())
+
+	principalSum := price.NewZero("EUR")
+	for _, installment := range schedule.Installments {
+		sum, err := installment.Principal.Add(installment.Interest)
+		require.NoError(t, err)
+		assert.True(t, sum.GetPayable().Equal(installment.Payment), "payment is principal plus interest")
+		assert.True(t, installment.Payment.IsPayable())
+		principalSum = principalSum.ForceAdd(installment.Principal)
+	}
+	assert.Equal(t, "10000.00", principalSum.GetPayable().Amount().Text('f', 2))
+
+	total, err := principalSum.Add(schedule.TotalInterest)
+	require.NoError(t, err)
+	assert.True(t, total.GetPayable().Equal(schedule.TotalPayment))
+}
+
+func TestAmortize_ZeroRate(t *testing.T) {
+	schedule, err := Amortize(price.NewFromInt(100, 1, "EUR"), price.Percent{}, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, "33.33", schedule.Installments[0].Payment.Amount().Text('f', 2))
+	assert.Equal(t, "33.34", schedule.Installments[2].Payment.Amount().Text('f', 2))
+	assert.True(t, schedule.TotalInterest.IsZero())
+	assert.Equal(t, "100.00", schedule.TotalPayment.Amount().Text('f', 2))
+}
+
+func TestAmortize_Validation(t *testing.T) {
+	_, err := Amortize(price.NewFromInt(100, 1, "EUR"), price.NewPercent(1), 0)
+	assert.Error(t, err)
+
+	_, err = Amortize(price.NewFromInt(-100, 1, "EUR"), price.NewPercent(1), 12)
+	assert.Error(t, err)
+
+	_, err = Amortize(price.NewFromInt(100, 1, "EUR"), price.NewPercent(-1), 12)
+	assert.Error(t, err)
+
+	_, err = Amortize(price.NewFromInt(100, 1, "EUR"), price.NewPercent(1), MaxPeriods+1)
+	assert.Error(t, err)
+	_, err = Amortize(price.NewFromInt(100, 1, "EUR"), price.NewPercent(1), MaxPeriods)
+	assert.NoError(t, err)
+
+	inf := price.NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+	for _, rate := range []price.Percent{price.NewPercent(0), price.NewPercent(1)} {
+		_, err = Amortize(inf, rate, 12)
+		assert.ErrorIs(t, err, price.ErrNotFinite)
+	}
+}
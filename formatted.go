@@ -0,0 +1,279 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Locale holds the number format of a language for ParseFormatted
+type Locale struct {
+	// Tag is the BCP 47 language tag, e.g. "de" or "de-CH"
+	Tag string
+	// DecimalSeparator separates the fraction, e.g. ',' for German
+	DecimalSeparator rune
+	// GroupSeparators are the accepted thousand separators, e.g. "." for German. Spaces are always accepted
+	GroupSeparators string
+}
+
+// Predefined locales, use LookupLocale to find them by language tag
+var (
+	LocaleEnglish = Locale{Tag: "en", DecimalSeparator: '.', GroupSeparators: ","}
+	LocaleGerman  = Locale{Tag: "de", DecimalSeparator: ',', GroupSeparators: "."}
+	LocaleSwiss   = Locale{Tag: "de-CH", DecimalSeparator: '.', GroupSeparators: "'’"}
+	LocaleFrench  = Locale{Tag: "fr", DecimalSeparator: ',', GroupSeparators: ""}
+	LocaleKhmer   = Locale{Tag: "km", DecimalSeparator: ',', GroupSeparators: "."}
+)
+
+var (
+	localesMu sync.RWMutex
+	// locales holds the locales by lower case tag
+	locales = map[string]Locale{}
+
+	currencySymbolsMu sync.RWMutex
+	// currencySymbols maps currency symbols to codes, ambiguous symbols map to the most common currency
+	currencySymbols = map[string]string{
+		"€":   "EUR",
+		"$":   "USD",
+		"US$": "USD",
+		"£":   "GBP",
+		"¥":   "JPY",
+		"₹":   "INR",
+		"₩":   "KRW",
+		"₽":   "RUB",
+		"₺":   "TRY",
+		"₫":   "VND",
+		"៛":   "KHR",
+		"฿":   "THB",
+		"zł":  "PLN",
+		"Kč":  "CZK",
+	}
+)
+
+func init() {
+	for _, locale := range []Locale{LocaleEnglish, LocaleGerman, LocaleSwiss, LocaleFrench, LocaleKhmer} {
+		locales[strings.ToLower(locale.Tag)] = locale
+	}
+	for _, tag := range []string{"es", "it", "nl", "pt", "id", "da", "tr"} {
+		locales[tag] = Locale{Tag: tag, DecimalSeparator: ',', GroupSeparators: "."}
+	}
+	for _, tag := range []string{"zh", "ja", "ko", "th"} {
+		locales[tag] = Locale{Tag: tag, DecimalSeparator: '.', GroupSeparators: ","}
+	}
+}
+
+// LookupLocale returns the locale of a BCP 47 language tag like "de-DE" or "de_DE", e.g. of language.German.String().
+// Tags without own locale fall back to their base language, the second return value is false if none is registered
+func LookupLocale(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	for {
+		if locale, ok := locales[tag]; ok {
+			return locale, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return Locale{}, false
+		}
+		tag = tag[:i]
+	}
+}
+
+// RegisterLocale adds a locale or overrides a predefined one
+func RegisterLocale(locale Locale) error {
+	if locale.Tag == "" {
+		return errors.New("locale tag must not be empty")
+	}
+	if locale.DecimalSeparator == 0 || strings.ContainsRune(locale.GroupSeparators, locale.DecimalSeparator) {
+		return errors.New("decimal separator must be set and differ from the group separators")
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(strings.ReplaceAll(locale.Tag, "_", "-"))] = locale
+	return nil
+}
+
+// RegisterCurrencySymbol maps a symbol like "€" to a currency code for ParseFormatted
+func RegisterCurrencySymbol(symbol string, code string) error {
+	if symbol == "" || code == "" {
+		return errors.New("symbol and currency code must not be empty")
+	}
+
+	currencySymbolsMu.Lock()
+	defer currencySymbolsMu.Unlock()
+	currencySymbols[symbol] = NewCurrency(code).String()
+	return nil
+}
+
+// ParseFormatted parses a formatted price like "1.234,56 €" (German), "€1,234.56" (English) or "CHF 1'234.50" (Swiss).
+// The currency may be a symbol or a registered currency code before or after the amount, a price without currency
+// has an empty currency. Negative amounts have a minus sign before the amount or currency, or are written in
+// parentheses, e.g. "(12.34 EUR)". Group separators are only accepted between groups of three digits, spaces are
+// accepted as group separator in every locale. Any other text returns an error matching ErrInvalidFormat:
+//
+//	locale, _ := price.LookupLocale(language.German.String())
+//	p, err := price.ParseFormatted("1.234,56 €", locale)
+func ParseFormatted(s string, locale Locale) (Price, error) {
+	invalid := newDetailedError(ErrInvalidFormat, "invalid formatted price "+s)
+	f := &formattedParser{runes: []rune(strings.TrimSpace(s)), locale: locale}
+
+	negative := false
+	if len(f.runes) > 0 && f.runes[0] == '(' {
+		if f.runes[len(f.runes)-1] != ')' {
+			return Price{}, invalid
+		}
+		negative = true
+		f.runes = f.runes[1 : len(f.runes)-1]
+	}
+
+	sign := f.sign()
+	prefix := f.currency()
+	if sign == 0 {
+		sign = f.sign()
+	}
+	number, ok := f.number()
+	if !ok {
+		return Price{}, invalid
+	}
+	suffix := f.currency()
+	f.spaces()
+	if f.pos != len(f.runes) || (prefix != "" && suffix != "") || (negative && sign != 0) {
+		return Price{}, invalid
+	}
+
+	code, err := formattedCurrency(prefix + suffix)
+	if err != nil {
+		return Price{}, err
+	}
+	am, _, err := new(big.Float).Parse(number, 10)
+	if err != nil {
+		return Price{}, invalid
+	}
+	if negative || sign == '-' {
+		am.Neg(am)
+	}
+	return NewFromBigFloat(*am, code), nil
+}
+
+// formattedParser reads the parts of a formatted price in order
+type formattedParser struct {
+	runes  []rune
+	pos    int
+	locale Locale
+}
+
+func (f *formattedParser) peek() rune {
+	return f.runeAt(f.pos)
+}
+
+func (f *formattedParser) spaces() {
+	for f.pos < len(f.runes) && unicode.IsSpace(f.runes[f.pos]) {
+		f.pos++
+	}
+}
+
+// sign reads an optional sign and returns '-', '+' or 0
+func (f *formattedParser) sign() rune {
+	f.spaces()
+	switch f.peek() {
+	case '-', '−':
+		f.pos++
+		return '-'
+	case '+':
+		f.pos++
+		return '+'
+	}
+	return 0
+}
+
+// currency reads an optional symbol or code, it ends at a space, digit, sign or parenthesis
+func (f *formattedParser) currency() string {
+	f.spaces()
+	start := f.pos
+	for f.pos < len(f.runes) {
+		r := f.runes[f.pos]
+		if unicode.IsSpace(r) || isDigit(r) || strings.ContainsRune("-−+()", r) {
+			break
+		}
+		f.pos++
+	}
+	return string(f.runes[start:f.pos])
+}
+
+// number reads the amount and returns it with '.' as decimal separator and without group separators
+func (f *formattedParser) number() (string, bool) {
+	f.spaces()
+	var (
+		number strings.Builder
+		group  = -1
+	)
+	for f.pos < len(f.runes) {
+		r := f.runes[f.pos]
+		switch {
+		case isDigit(r):
+			number.WriteRune(r)
+			if group >= 0 {
+				group++
+			}
+		case (strings.ContainsRune(f.locale.GroupSeparators, r) || unicode.IsSpace(r)) && isDigit(f.runeAt(f.pos+1)):
+			// the first group has one to three digits, the following ones exactly three
+			if (group < 0 && (number.Len() == 0 || number.Len() > 3)) || (group >= 0 && group != 3) {
+				return "", false
+			}
+			group = 0
+		default:
+			return f.fraction(&number, group)
+		}
+		f.pos++
+	}
+	return f.fraction(&number, group)
+}
+
+// fraction reads the optional decimal separator and fraction digits after the integer digits
+func (f *formattedParser) fraction(number *strings.Builder, group int) (string, bool) {
+	if number.Len() == 0 || (group >= 0 && group != 3) {
+		return "", false
+	}
+	if f.peek() != f.locale.DecimalSeparator {
+		return number.String(), true
+	}
+	f.pos++
+	number.WriteByte('.')
+	digits := 0
+	for isDigit(f.peek()) {
+		number.WriteRune(f.peek())
+		f.pos++
+		digits++
+	}
+	return number.String(), digits > 0
+}
+
+func (f *formattedParser) runeAt(i int) rune {
+	if i >= len(f.runes) {
+		return 0
+	}
+	return f.runes[i]
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// formattedCurrency returns the currency code of a symbol or a registered code
+func formattedCurrency(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	currencySymbolsMu.RLock()
+	code, ok := currencySymbols[s]
+	currencySymbolsMu.RUnlock()
+	if ok {
+		return code, nil
+	}
+	currency, err := ParseCurrency(s)
+	return currency.String(), err
+}
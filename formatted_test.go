@@ -0,0 +1,104 @@
+package price
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormatted(t *testing.T) {
+	tests := []struct {
+		input  string
+		locale Locale
+		want   Price
+	}{
+		{input: "1.234,56 €", locale: LocaleGerman, want: NewFromInt(123456, 100, "EUR")},
+		{input: "1.234,56\u00a0€", locale: LocaleGerman, want: NewFromInt(123456, 100, "EUR")},
+		{input: "-12,5 EUR", locale: LocaleGerman, want: NewFromInt(-125, 10, "EUR")},
+		{input: "€1,234.56", locale: LocaleEnglish, want: NewFromInt(123456, 100, "EUR")},
+		{input: "$ 1,000,000", locale: LocaleEnglish, want: NewFromInt(1000000, 1, "USD")},
+		{input: "(12.34 usd)", locale: LocaleEnglish, want: NewFromInt(-1234, 100, "USD")},
+		{input: "CHF 1'234.50", locale: LocaleSwiss, want: NewFromInt(123450, 100, "CHF")},
+		{input: "1\u202f234,56 €", locale: LocaleFrench, want: NewFromInt(123456, 100, "EUR")},
+		{input: "4.000 ៛", locale: LocaleKhmer, want: NewFromInt(4000, 1, "KHR")},
+		{input: "¥1,200", locale: LocaleEnglish, want: NewFromInt(1200, 1, "JPY")},
+		{input: "12.34", locale: LocaleEnglish, want: NewFromInt(1234, 100, "")},
+		{input: "€ -5", locale: LocaleGerman, want: NewFromInt(-5, 1, "EUR")},
+		{input: "-€5", locale: LocaleGerman, want: NewFromInt(-5, 1, "EUR")},
+		{input: "+1.234.567 €", locale: LocaleGerman, want: NewFromInt(1234567, 1, "EUR")},
+		{input: "1234,5 €", locale: LocaleGerman, want: NewFromInt(12345, 10, "EUR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p, err := ParseFormatted(tt.input, tt.locale)
+			require.NoError(t, err)
+			assert.True(t, tt.want.LikelyEqual(p), "got %s", p.String())
+			assert.Equal(t, tt.want.Currency(), p.Currency())
+		})
+	}
+
+	for _, input := range []string{
+		"", "€", "1,2,3 €", "12, EUR", ",5 EUR", "12x34",
+		"12€34", "1-1", "1 2 3 €", "1.2.3,4 €", "(5", "€ 5 (", "5)", "(-5 €)", "€ 5 EUR", "12.34 €-", "1.23 €", "1234.567,8 €",
+	} {
+		_, err := ParseFormatted(input, LocaleGerman)
+		assert.ErrorIs(t, err, ErrInvalidFormat, input)
+	}
+	for _, input := range []string{"1,23 $", "12,34.5 $", "$1,2345"} {
+		_, err := ParseFormatted(input, LocaleEnglish)
+		assert.ErrorIs(t, err, ErrInvalidFormat, input)
+	}
+
+	_, err := ParseFormatted("12,34 XYZ", LocaleGerman)
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+	_, err = ParseFormatted("12,34 ABC", LocaleGerman)
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+	_, err = ParseFormatted("12x34", LocaleGerman)
+	assert.EqualError(t, err, "invalid formatted price 12x34")
+}
+
+func TestLookupLocale(t *testing.T) {
+	locale, ok := LookupLocale("de")
+	require.True(t, ok)
+	assert.Equal(t, LocaleGerman, locale)
+
+	locale, ok = LookupLocale("de_DE")
+	require.True(t, ok)
+	assert.Equal(t, LocaleGerman, locale)
+
+	locale, ok = LookupLocale("de-CH")
+	require.True(t, ok)
+	assert.Equal(t, LocaleSwiss, locale)
+
+	locale, ok = LookupLocale("en-US")
+	require.True(t, ok)
+	assert.Equal(t, LocaleEnglish, locale)
+
+	_, ok = LookupLocale("xx")
+	assert.False(t, ok)
+}
+
+func TestRegisterLocale(t *testing.T) {
+	require.NoError(t, RegisterLocale(Locale{Tag: "xx-YY", DecimalSeparator: '·', GroupSeparators: ","}))
+	locale, ok := LookupLocale("xx-yy")
+	require.True(t, ok)
+
+	p, err := ParseFormatted("1,234·5 EUR", locale)
+	require.NoError(t, err)
+	assert.True(t, NewFromInt(12345, 10, "EUR").LikelyEqual(p))
+
+	assert.Error(t, RegisterLocale(Locale{DecimalSeparator: '.'}))
+	assert.Error(t, RegisterLocale(Locale{Tag: "zz", DecimalSeparator: '.', GroupSeparators: "."}))
+}
+
+func TestRegisterCurrencySymbol(t *testing.T) {
+	require.NoError(t, RegisterCurrencySymbol("R$", "brl"))
+	p, err := ParseFormatted("R$ 1.234,56", LocaleGerman)
+	require.NoError(t, err)
+	assert.True(t, NewFromInt(123456, 100, "BRL").LikelyEqual(p))
+	assert.Equal(t, "BRL", p.Currency())
+
+	assert.Error(t, RegisterCurrencySymbol("", "BRL"))
+}
@@ -0,0 +1,28 @@
+package price
+
+// gormDataTypeJSON is the column type GORM migrations create for prices, it matches the JSON of Value
+const gormDataTypeJSON = "json"
+
+// GormDataType implements the schema.GormDataTypeInterface of gorm.io without depending on it.
+// GORM reads and writes Price fields with Value and Scan, so models need no hooks or serializer tags:
+//
+//	type Product struct {
+//		ID    uint
+//		Price price.Price
+//	}
+//
+// AutoMigrate creates a json column, use SQLDecimal or SQLMinorUnits for numeric columns.
+// The gormprice package provides a serializer for fields that need the serializer tag, e.g. pointers
+func (p Price) GormDataType() string {
+	return gormDataTypeJSON
+}
+
+// GormDataType implements the schema.GormDataTypeInterface of gorm.io, see Price.GormDataType
+func (a Discount) GormDataType() string {
+	return gormDataTypeJSON
+}
+
+// GormDataType implements the schema.GormDataTypeInterface of gorm.io, see Price.GormDataType
+func (c Charges) GormDataType() string {
+	return gormDataTypeJSON
+}
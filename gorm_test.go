@@ -0,0 +1,13 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGormDataType(t *testing.T) {
+	assert.Equal(t, "json", NewZero("EUR").GormDataType())
+	assert.Equal(t, "json", Discount{}.GormDataType())
+	assert.Equal(t, "json", Charges{}.GormDataType())
+}
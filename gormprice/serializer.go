@@ -0,0 +1,70 @@
+// Package gormprice provides a gorm.io serializer for price.Price, price.Discount and price.Charges.
+//
+// Importing the package registers the serializer under the name "price", so models only need a tag:
+//
+//	type Order struct {
+//		ID       uint
+//		Total    price.Price     `gorm:"serializer:price"`
+//		Discount *price.Discount `gorm:"serializer:price"`
+//		Charges  price.Charges   `gorm:"serializer:price"`
+//	}
+//
+// The columns hold the JSON of the Value methods, AutoMigrate creates json columns
+// because of the GormDataType methods of the price types.
+package gormprice
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// Name is the name the serializer is registered with
+const Name = "price"
+
+// Serializer implements schema.SerializerInterface for fields whose type, or pointer element type,
+// implements sql.Scanner and driver.Valuer like price.Price, price.Discount and price.Charges.
+// Nil pointers are stored as NULL and NULL is read as nil pointer.
+type Serializer struct{}
+
+func init() {
+	schema.RegisterSerializer(Name, Serializer{})
+}
+
+// Scan implements schema.SerializerInterface
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType).Elem()
+	if field.FieldType.Kind() != reflect.Ptr || dbValue != nil {
+		target := fieldValue
+		if field.FieldType.Kind() == reflect.Ptr {
+			target = reflect.New(field.FieldType.Elem())
+			fieldValue.Set(target)
+			target = target.Elem()
+		}
+		scanner, ok := target.Addr().Interface().(sql.Scanner)
+		if !ok {
+			return fmt.Errorf("%s: type %s does not implement sql.Scanner", field.Name, field.FieldType)
+		}
+		if err := scanner.Scan(dbValue); err != nil {
+			return err
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface
+func (Serializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	if v := reflect.ValueOf(fieldValue); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, nil
+	}
+	valuer, ok := fieldValue.(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("%s: type %s does not implement driver.Valuer", field.Name, field.FieldType)
+	}
+	return valuer.Value()
+}
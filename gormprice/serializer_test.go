@@ -0,0 +1,72 @@
+package gormprice
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/schema"
+
+	price "github.com/maohieng/go-price"
+)
+
+type order struct {
+	ID       uint
+	Total    price.Price     `gorm:"serializer:price"`
+	Sale     *price.Price    `gorm:"serializer:price"`
+	Discount *price.Discount `gorm:"serializer:price"`
+	Charges  price.Charges   `gorm:"serializer:price"`
+	Note     string          `gorm:"serializer:price"`
+}
+
+func parseOrder(t *testing.T) *schema.Schema {
+	t.Helper()
+	s, err := schema.Parse(&order{}, &sync.Map{}, schema.NamingStrategy{})
+	require.NoError(t, err)
+	return s
+}
+
+func TestSerializer_Registered(t *testing.T) {
+	s := parseOrder(t)
+	for _, name := range []string{"Total", "Sale", "Discount", "Charges"} {
+		assert.Equal(t, Serializer{}, s.LookUpField(name).Serializer, name)
+	}
+	assert.Equal(t, "json", string(s.LookUpField("Total").DataType))
+	assert.Equal(t, "json", string(s.LookUpField("Charges").DataType))
+}
+
+func TestSerializer_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := parseOrder(t)
+	discount, err := price.NewPercentDiscount(price.NewPercent(10))
+	require.NoError(t, err)
+	src := order{Total: price.NewFromInt(1234, 100, "EUR"), Discount: &discount}
+	dst := reflect.ValueOf(&order{}).Elem()
+
+	for _, name := range []string{"Total", "Sale", "Discount", "Charges"} {
+		field := s.LookUpField(name)
+		value, err := Serializer{}.Value(ctx, field, reflect.ValueOf(src), reflect.ValueOf(src).FieldByName(name).Interface())
+		require.NoError(t, err, name)
+		require.NoError(t, Serializer{}.Scan(ctx, field, dst, value), name)
+	}
+
+	decoded := dst.Interface().(order)
+	assert.True(t, decoded.Total.Equal(src.Total))
+	assert.Nil(t, decoded.Sale, "NULL is read as nil pointer")
+	require.NotNil(t, decoded.Discount)
+	assert.Equal(t, discount.Percentage, decoded.Discount.Percentage)
+}
+
+func TestSerializer_Errors(t *testing.T) {
+	ctx := context.Background()
+	s := parseOrder(t)
+	dst := reflect.ValueOf(&order{}).Elem()
+
+	assert.Error(t, Serializer{}.Scan(ctx, s.LookUpField("Total"), dst, "not a price"))
+	assert.Error(t, Serializer{}.Scan(ctx, s.LookUpField("Note"), dst, "note"))
+	_, err := Serializer{}.Value(ctx, s.LookUpField("Note"), dst, "note")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package price
+
+import "sync"
+
+// ChargesHooks are optional callbacks invoked when Charges change.
+// They are meant for observability (metrics, audit logs) and must not modify the given charges.
+// Unset callbacks are ignored.
+type ChargesHooks struct {
+	// OnAdd is called when a charge with a new qualifier is added
+	OnAdd func(qualifier ChargeQualifier, added Charge)
+	// OnMerge is called when a charge is added to an existing charge with the same qualifier
+	OnMerge func(qualifier ChargeQualifier, before, after Charge)
+	// OnCap is called when a charge is reduced to a maximum amount
+	OnCap func(qualifier ChargeQualifier, before, after Charge)
+}
+
+var (
+	chargesHooksMu sync.RWMutex
+	chargesHooks   ChargesHooks
+)
+
+// SetChargesHooks registers the hooks invoked by all Charges operations, replacing the previous ones.
+// Pass an empty ChargesHooks to remove them.
+func SetChargesHooks(hooks ChargesHooks) {
+	chargesHooksMu.Lock()
+	defer chargesHooksMu.Unlock()
+	chargesHooks = hooks
+}
+
+func currentChargesHooks() ChargesHooks {
+	chargesHooksMu.RLock()
+	defer chargesHooksMu.RUnlock()
+	return chargesHooks
+}
+
+func (h ChargesHooks) added(qualifier ChargeQualifier, added Charge) {
+	if h.OnAdd != nil {
+		h.OnAdd(qualifier, added)
+	}
+}
+
+func (h ChargesHooks) merged(qualifier ChargeQualifier, before, after Charge) {
+	if h.OnMerge != nil {
+		h.OnMerge(qualifier, before, after)
+	}
+}
+
+func (h ChargesHooks) capped(qualifier ChargeQualifier, before, after Charge) {
+	if h.OnCap != nil {
+		h.OnCap(qualifier, before, after)
+	}
+}
@@ -0,0 +1,57 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChargesHooks(t *testing.T) {
+	var added, merged, capped []ChargeQualifier
+	SetChargesHooks(ChargesHooks{
+		OnAdd: func(qualifier ChargeQualifier, _ Charge) {
+			added = append(added, qualifier)
+		},
+		OnMerge: func(qualifier ChargeQualifier, before, after Charge) {
+			assert.True(t, before.Price.Equal(NewFromInt(20, 1, "EUR")))
+			assert.True(t, after.Price.Equal(NewFromInt(30, 1, "EUR")))
+			merged = append(merged, qualifier)
+		},
+		OnCap: func(qualifier ChargeQualifier, before, after Charge) {
+			assert.True(t, before.Price.Equal(NewFromInt(30, 1, "EUR")))
+			assert.True(t, after.Price.Equal(NewFromInt(25, 1, "EUR")))
+			capped = append(capped, qualifier)
+		},
+	})
+	defer SetChargesHooks(ChargesHooks{})
+
+	giftCard := ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"}
+
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(20, 1, "EUR"), Value: NewFromInt(20, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(10, 1, "EUR"), Value: NewFromInt(10, 1, "EUR")})
+	charges, err := charges.Cap(giftCard, NewFromInt(25, 1, "EUR"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []ChargeQualifier{giftCard}, added)
+	assert.Equal(t, []ChargeQualifier{giftCard}, merged)
+	assert.Equal(t, []ChargeQualifier{giftCard}, capped)
+
+	charge, _ := charges.GetByChargeQualifier(giftCard)
+	assert.True(t, charge.Value.Equal(NewFromInt(25, 1, "EUR")))
+}
+
+func TestCharges_Cap(t *testing.T) {
+	qualifier := ChargeQualifier{Type: ChargeTypeMain}
+	charges := Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(100, 1, "points"), Value: NewFromInt(10, 1, "EUR")})
+
+	capped, err := charges.Cap(qualifier, NewFromInt(50, 1, "points"))
+	require.NoError(t, err)
+	charge, _ := capped.GetByChargeQualifier(qualifier)
+	assert.True(t, charge.Price.Equal(NewFromInt(50, 1, "points")))
+	assert.True(t, charge.Value.Equal(NewFromInt(5, 1, "EUR")))
+
+	_, err = charges.Cap(qualifier, NewFromInt(50, 1, "EUR"))
+	assert.Error(t, err)
+}
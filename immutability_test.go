@@ -0,0 +1,55 @@
+package price
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPublicAPI_DoesNotLeakMutableState calls every exported method without arguments that returns
+// a *big.Float, *big.Rat or *big.Int, changes the result and checks that the receiver stays unchanged
+func TestPublicAPI_DoesNotLeakMutableState(t *testing.T) {
+	rate, err := NewExchangeRate("EUR", "USD", *big.NewFloat(1.25), time.Now())
+	require.NoError(t, err)
+	exact, err := ParseExact("12.34", "EUR")
+	require.NoError(t, err)
+
+	values := []interface{}{
+		NewFromFloat(12.34, "EUR"),
+		NewFromInt(1234, 100, "EUR"),
+		NewPercentFromFloat(19.5),
+		rate,
+		exact,
+	}
+
+	checked := 0
+	for _, value := range values {
+		before := reflect.ValueOf(value).MethodByName("String").Call(nil)[0].String()
+		typ := reflect.TypeOf(value)
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := typ.Method(i)
+			if method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+				continue
+			}
+			result := method.Func.Call([]reflect.Value{reflect.ValueOf(value)})[0].Interface()
+			switch r := result.(type) {
+			case *big.Float:
+				r.SetInt64(987654321)
+			case *big.Rat:
+				r.SetInt64(987654321)
+			case *big.Int:
+				r.SetInt64(987654321)
+			default:
+				continue
+			}
+			checked++
+			after := reflect.ValueOf(value).MethodByName("String").Call(nil)[0].String()
+			assert.Equal(t, before, after, "%s.%s leaks mutable state", typ.Name(), method.Name)
+		}
+	}
+	assert.NotZero(t, checked)
+}
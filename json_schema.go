@@ -0,0 +1,50 @@
+package price
+
+import "sort"
+
+// jsonSchemaAmountPattern matches the decimal amounts written by MarshalJSON, large amounts have an exponent like "1e+21"
+const jsonSchemaAmountPattern = `^-?[0-9]+(\.[0-9]+)?(e[-+][0-9]+)?$`
+
+// JSONSchema returns the JSON Schema of the JSON format of Price. The currency is optional since prices without
+// currency omit it, e.g. the zero price {"amount":"0"}. Any currency code is accepted unless currencies are given
+// for an enum, e.g. JSONSchema(CurrencyCodes()...) for the registered ISO 4217 currencies.
+// The schema only uses keywords of OpenAPI 3 schema objects, so it can also be added to the components of an API spec:
+//
+//	spec.Components.Schemas["Price"] = price.JSONSchema()
+func JSONSchema(currencies ...string) map[string]interface{} {
+	currency := map[string]interface{}{
+		"type":        "string",
+		"description": "Currency code like \"EUR\", omitted for prices without currency",
+		"example":     "EUR",
+	}
+	if len(currencies) > 0 {
+		currency["enum"] = currencies
+	}
+
+	return map[string]interface{}{
+		"type":        "object",
+		"description": "Price with decimal amount and currency",
+		"properties": map[string]interface{}{
+			"amount": map[string]interface{}{
+				"type":        "string",
+				"description": "Decimal amount, e.g. \"12.34\" or \"1.5e+12\"",
+				"pattern":     jsonSchemaAmountPattern,
+				"example":     "12.34",
+			},
+			"currency": currency,
+		},
+		"required": []string{"amount"},
+	}
+}
+
+// CurrencyCodes returns the sorted codes of the registered currencies, see RegisterCurrency
+func CurrencyCodes() []string {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
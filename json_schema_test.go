@@ -0,0 +1,133 @@
+package price
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonSchemaObject is the subset of JSON Schema used by JSONSchema
+type jsonSchemaObject struct {
+	Type       string   `json:"type"`
+	Required   []string `json:"required"`
+	Properties map[string]struct {
+		Type    string   `json:"type"`
+		Pattern string   `json:"pattern"`
+		Enum    []string `json:"enum"`
+	} `json:"properties"`
+}
+
+func decodeJSONSchema(t *testing.T, schema map[string]interface{}) jsonSchemaObject {
+	t.Helper()
+	data, err := json.Marshal(schema)
+	require.NoError(t, err)
+	var decoded jsonSchemaObject
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+// validate returns whether the JSON document is valid against the schema
+func (s jsonSchemaObject) validate(t *testing.T, data []byte) bool {
+	t.Helper()
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &document))
+	for _, key := range s.Required {
+		if _, ok := document[key]; !ok {
+			return false
+		}
+	}
+	for key, value := range document {
+		property, ok := s.Properties[key]
+		if !ok {
+			continue
+		}
+		str, ok := value.(string)
+		if !ok || property.Type != "string" {
+			return false
+		}
+		if property.Pattern != "" && !regexp.MustCompile(property.Pattern).MatchString(str) {
+			return false
+		}
+		if len(property.Enum) > 0 && !contains(property.Enum, str) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestJSONSchema(t *testing.T) {
+	schema := decodeJSONSchema(t, JSONSchema())
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, []string{"amount"}, schema.Required)
+	assert.Empty(t, schema.Properties["currency"].Enum)
+
+	discount, err := json.Marshal(Discount{})
+	require.NoError(t, err)
+	var zeroDiscount struct {
+		Price json.RawMessage `json:"price"`
+	}
+	require.NoError(t, json.Unmarshal(discount, &zeroDiscount))
+	assert.JSONEq(t, `{"amount":"0"}`, string(zeroDiscount.Price))
+	assert.True(t, schema.validate(t, zeroDiscount.Price))
+
+	for _, p := range []Price{
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-5, 1, "EUR"),
+		NewZero("EUR"),
+		NewZero(""),
+		NewFromFloat(1e21, "EUR"),
+		NewFromInt(300, 1, "points"),
+		NewFromFloat(99.9, "miles"),
+	} {
+		data, err := p.MarshalJSON()
+		require.NoError(t, err)
+		assert.True(t, schema.validate(t, data), string(data))
+	}
+
+	assert.False(t, schema.validate(t, []byte(`{"amount":"12,34","currency":"EUR"}`)))
+	assert.False(t, schema.validate(t, []byte(`{"amount":"+Inf","currency":"EUR"}`)))
+	assert.False(t, schema.validate(t, []byte(`{"currency":"EUR"}`)))
+}
+
+func TestJSONSchema_Currencies(t *testing.T) {
+	schema := decodeJSONSchema(t, JSONSchema(CurrencyCodes()...))
+	enum := schema.Properties["currency"].Enum
+	assert.Contains(t, enum, "EUR")
+	assert.Contains(t, enum, "JPY")
+	assert.IsIncreasing(t, enum)
+
+	eur, err := NewFromInt(1234, 100, "EUR").MarshalJSON()
+	require.NoError(t, err)
+	assert.True(t, schema.validate(t, eur))
+	withoutCurrency, err := NewZero("").MarshalJSON()
+	require.NoError(t, err)
+	assert.True(t, schema.validate(t, withoutCurrency))
+	points, err := NewFromInt(300, 1, "points").MarshalJSON()
+	require.NoError(t, err)
+	assert.False(t, schema.validate(t, points))
+
+	custom := decodeJSONSchema(t, JSONSchema(append(CurrencyCodes(), "points")...))
+	assert.True(t, custom.validate(t, points))
+}
+
+func TestJSONSchema_RegisteredCurrency(t *testing.T) {
+	require.NoError(t, RegisterCurrency(CurrencyInfo{Code: "xts", MinorUnits: 2}))
+	defer func() {
+		currenciesMu.Lock()
+		delete(currencies, "XTS")
+		currenciesMu.Unlock()
+	}()
+	assert.Contains(t, CurrencyCodes(), "XTS")
+}
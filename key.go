@@ -0,0 +1,45 @@
+package price
+
+import (
+	"hash/fnv"
+)
+
+// Key is a comparable representation of a price, e.g. to use prices as map keys or for dedupe and caching layers
+type Key struct {
+	// Amount is the exact decimal value of the amount without exponent, e.g. "12.5".
+	// Binary approximations of decimals have long expansions, e.g. NewFromFloat(0.1, "EUR") has
+	// "0.1000000000000000055511151231257827021181583404541015625"
+	Amount string
+	// Currency as given to the price
+	Currency string
+}
+
+// Key returns the canonical comparable key of the price. Prices have equal keys if and only if they are Equal,
+// independent of the precision of their amounts. Negative zero is normalized.
+// Round prices first (e.g. with GetPayable) to treat amounts like a float 0.1 and a parsed "0.1" alike
+func (p Price) Key() Key {
+	return Key{
+		Amount:   p.exactAmount(),
+		Currency: p.currency,
+	}
+}
+
+// Hash64 returns the 64-bit FNV-1a hash of the price key
+func (p Price) Hash64() uint64 {
+	return p.Key().Hash64()
+}
+
+// Hash64 returns the 64-bit FNV-1a hash of the key
+func (k Key) Hash64() uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(k.Amount))
+	// the separator avoids collisions like "1"+"2EUR" and "12"+"EUR"
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(k.Currency))
+	return h.Sum64()
+}
+
+// String returns the key as "<amount> <currency>"
+func (k Key) String() string {
+	return k.Amount + " " + k.Currency
+}
@@ -0,0 +1,61 @@
+package price_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestPrice_Key(t *testing.T) {
+	var parsed price.Price
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"12.5","currency":"EUR"}`), &parsed))
+
+	fromFloat := price.NewFromFloat(12.5, "EUR")
+	assert.Equal(t, fromFloat.Key(), parsed.Key())
+	assert.Equal(t, price.Key{Amount: "12.5", Currency: "EUR"}, fromFloat.Key())
+	assert.Equal(t, "12.5 EUR", fromFloat.Key().String())
+
+	negativeZero := price.NewFromBigFloat(*new(big.Float).Neg(big.NewFloat(0)), "EUR")
+	assert.Equal(t, price.NewZero("EUR").Key(), negativeZero.Key())
+
+	assert.NotEqual(t, price.NewFromFloat(12.5, "USD").Key(), fromFloat.Key())
+	assert.NotEqual(t, price.NewFromFloat(12.25, "EUR").Key(), fromFloat.Key())
+
+	seen := map[price.Key]int{}
+	for _, p := range []price.Price{fromFloat, parsed, price.NewFromInt(125, 10, "EUR"), price.NewZero("EUR")} {
+		seen[p.Key()]++
+	}
+	assert.Len(t, seen, 2)
+}
+
+func TestPrice_KeyPrecision(t *testing.T) {
+	a := price.NewFromFloat(0.1, "EUR")
+	b, err := a.Add(price.NewFromInt(0, 1, "EUR"))
+	require.NoError(t, err)
+	require.NotEqual(t, a.Amount().Prec(), b.Amount().Prec())
+	require.True(t, a.Equal(b))
+
+	assert.Equal(t, a.Key(), b.Key())
+	assert.Equal(t, a.Hash64(), b.Hash64())
+	assert.Equal(t, "0.1000000000000000055511151231257827021181583404541015625", a.Key().Amount)
+
+	// prices of different binary values are not equal and have different keys, round them first to compare decimals
+	var parsed price.Price
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"0.1","currency":"EUR"}`), &parsed))
+	assert.False(t, a.Equal(parsed))
+	assert.NotEqual(t, a.Key(), parsed.Key())
+	assert.Equal(t, a.GetPayable().Key(), parsed.GetPayable().Key())
+}
+
+func TestPrice_Hash64(t *testing.T) {
+	a := price.NewFromFloat(12.5, "EUR")
+	assert.Equal(t, a.Hash64(), price.NewFromInt(125, 10, "EUR").Hash64())
+	assert.Equal(t, a.Hash64(), a.Key().Hash64())
+	assert.NotEqual(t, a.Hash64(), price.NewFromFloat(12.5, "USD").Hash64())
+	assert.NotEqual(t, price.NewFromInt(1, 1, "2EUR").Hash64(), price.NewFromInt(12, 1, "EUR").Hash64())
+}
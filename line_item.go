@@ -0,0 +1,57 @@
+package price
+
+import (
+	"time"
+)
+
+// LineItem is a line of an invoice or cart, quantity times unit price reduced by the discounts and taxed with TaxRate
+type LineItem struct {
+	UnitPrice Price
+	Quantity  int
+	TaxRate   TaxRate
+	Discounts Discounts
+}
+
+// RowSubtotal returns the payable unit price multiplied by the quantity before discounts
+func (l LineItem) RowSubtotal() Price {
+	return l.UnitPrice.Multiply(l.Quantity).GetPayable()
+}
+
+// RowDiscount returns the payable sum of the discounts active now, stacked sequentially
+func (l LineItem) RowDiscount() (Price, error) {
+	_, applied, err := l.applyDiscounts(StackingSequential, time.Now())
+	if err != nil {
+		return Price{}, err
+	}
+	discount := NewZero(l.UnitPrice.Currency())
+	for _, a := range applied {
+		discount = discount.ForceAdd(a.Amount)
+	}
+	return discount.GetPayable(), nil
+}
+
+// RowTotal returns the payable row price after the discounts active now, stacked sequentially
+func (l LineItem) RowTotal() (Price, error) {
+	total, _, err := l.applyDiscounts(StackingSequential, time.Now())
+	if err != nil {
+		return Price{}, err
+	}
+	return total.GetPayable(), nil
+}
+
+// RowTax returns the payable tax of RowTotal, the unit price is treated as net price
+func (l LineItem) RowTax() (Price, error) {
+	total, err := l.RowTotal()
+	if err != nil {
+		return Price{}, err
+	}
+	taxed, err := TaxedPriceFromNet(total, l.TaxRate)
+	if err != nil {
+		return Price{}, err
+	}
+	return taxed.Tax, nil
+}
+
+func (l LineItem) applyDiscounts(stacking StackingMode, at time.Time) (Price, []AppliedDiscount, error) {
+	return l.Discounts.ApplyAt(l.RowSubtotal(), stacking, at)
+}
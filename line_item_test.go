@@ -0,0 +1,39 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineItem_Rows(t *testing.T) {
+	item := LineItem{
+		UnitPrice: NewFromInt(333, 100, "EUR"),
+		Quantity:  3,
+		TaxRate:   mustTaxRate(t, "19"),
+		Discounts: Discounts{{BasisPoints: 1250}},
+	}
+
+	assert.Equal(t, "9.99", item.RowSubtotal().Amount().Text('f', 2))
+
+	discount, err := item.RowDiscount()
+	require.NoError(t, err)
+	assert.Equal(t, "1.25", discount.Amount().Text('f', 2))
+
+	total, err := item.RowTotal()
+	require.NoError(t, err)
+	assert.Equal(t, "8.74", total.Amount().Text('f', 2))
+
+	tax, err := item.RowTax()
+	require.NoError(t, err)
+	assert.Equal(t, "1.66", tax.Amount().Text('f', 2))
+
+	withoutDiscount := LineItem{UnitPrice: NewFromInt(5, 1, "EUR"), Quantity: 2}
+	discount, err = withoutDiscount.RowDiscount()
+	require.NoError(t, err)
+	assert.True(t, discount.IsZero())
+
+	_, err = LineItem{UnitPrice: NewFromInt(5, 1, "EUR"), Quantity: 1, Discounts: Discounts{{Price: NewFromInt(1, 1, "USD")}}}.RowTotal()
+	assert.Error(t, err)
+}
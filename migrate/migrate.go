@@ -0,0 +1,161 @@
+// Package migrate converts legacy float64 based amounts into price.Price and price.Charges.
+//
+// A Migrator rounds every amount with a configurable policy and records each amount
+// that had to be adjusted, so bulk data migrations can be reviewed afterwards.
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	price "github.com/maohieng/go-price"
+)
+
+type (
+	// Options configure the rounding applied during the migration
+	Options struct {
+		// RoundingMode used for all amounts, e.g. price.RoundingModeHalfUp. If empty the payable rounding of the currency is used
+		RoundingMode price.RoundingMode
+		// Precision used together with RoundingMode, e.g. 100 for cents
+		Precision int
+	}
+
+	// Adjustment describes an amount that was changed by the migration
+	Adjustment struct {
+		// Field identifies the migrated value, e.g. a document id and field name
+		Field string
+		// Original is the legacy float amount
+		Original float64
+		// Migrated is the resulting price
+		Migrated price.Price
+		// Difference is Migrated minus Original
+		Difference *big.Float
+	}
+
+	// Report summarizes a migration
+	Report struct {
+		// Migrated is the count of migrated amounts
+		Migrated int
+		// Adjustments lists all amounts which were changed by rounding
+		Adjustments []Adjustment
+	}
+
+	// LegacyCharge is a float64 based charge as stored by legacy models
+	LegacyCharge struct {
+		Type          string
+		Reference     string
+		PriceAmount   float64
+		PriceCurrency string
+		ValueAmount   float64
+		ValueCurrency string
+	}
+
+	// Migrator converts legacy amounts and keeps a report of the adjustments made
+	Migrator struct {
+		options Options
+		report  Report
+	}
+)
+
+// tolerance below which differences are caused by the binary representation of the amount only
+var tolerance = big.NewFloat(0.000000001)
+
+// New creates a Migrator
+func New(options Options) (*Migrator, error) {
+	if options.RoundingMode == "" {
+		return &Migrator{options: options}, nil
+	}
+	if err := options.RoundingMode.Validate(); err != nil {
+		return nil, err
+	}
+	if options.Precision <= 0 {
+		return nil, errors.New("precision must be higher than zero")
+	}
+	return &Migrator{options: options}, nil
+}
+
+// Price converts a legacy float amount into a rounded price, field is used to identify the amount in the report
+func (m *Migrator) Price(field string, amount float64, currency string) (price.Price, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return price.NewZero(currency), fmt.Errorf("%s: invalid amount %v", field, amount)
+	}
+
+	// the shortest decimal representation is what the legacy model meant to store
+	original, _, err := new(big.Float).SetPrec(128).Parse(strconv.FormatFloat(amount, 'f', -1, 64), 10)
+	if err != nil {
+		return price.NewZero(currency), fmt.Errorf("%s: %w", field, err)
+	}
+
+	migrated := m.round(price.NewFromBigFloat(*original, currency))
+	m.report.Migrated++
+
+	diff := new(big.Float).SetPrec(128).Sub(migrated.Amount(), original)
+	if new(big.Float).Abs(diff).Cmp(tolerance) > 0 {
+		m.report.Adjustments = append(m.report.Adjustments, Adjustment{
+			Field:      field,
+			Original:   amount,
+			Migrated:   migrated,
+			Difference: diff,
+		})
+	}
+
+	return migrated, nil
+}
+
+// MutablePrice converts a MutablePrice document into a rounded price using its float Amount and Currency
+func (m *Migrator) MutablePrice(field string, mp price.MutablePrice) (price.Price, error) {
+	return m.Price(field, mp.Amount, mp.Currency)
+}
+
+// Charge converts a legacy charge, an empty value currency means the value equals the price
+func (m *Migrator) Charge(field string, legacy LegacyCharge) (price.Charge, error) {
+	p, err := m.Price(field+".price", legacy.PriceAmount, legacy.PriceCurrency)
+	if err != nil {
+		return price.Charge{}, err
+	}
+
+	value := p
+	if legacy.ValueCurrency != "" {
+		value, err = m.Price(field+".value", legacy.ValueAmount, legacy.ValueCurrency)
+		if err != nil {
+			return price.Charge{}, err
+		}
+	}
+
+	return price.Charge{
+		Type:      legacy.Type,
+		Reference: legacy.Reference,
+		Price:     p,
+		Value:     value,
+	}, nil
+}
+
+// Charges converts a list of legacy charges, charges with the same type and reference are summed up
+func (m *Migrator) Charges(field string, legacy []LegacyCharge) (price.Charges, error) {
+	charges := price.Charges{}
+	for i, l := range legacy {
+		charge, err := m.Charge(fmt.Sprintf("%s[%d]", field, i), l)
+		if err != nil {
+			return price.Charges{}, err
+		}
+		charges = charges.AddCharge(charge)
+	}
+	return charges, nil
+}
+
+// Report returns the report of all conversions done so far
+func (m *Migrator) Report() Report {
+	report := m.report
+	report.Adjustments = append([]Adjustment(nil), m.report.Adjustments...)
+	return report
+}
+
+func (m *Migrator) round(p price.Price) price.Price {
+	if m.options.RoundingMode == "" {
+		return p.GetPayable()
+	}
+	return p.GetPayableByRoundingMode(m.options.RoundingMode, m.options.Precision)
+}
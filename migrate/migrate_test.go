@@ -0,0 +1,71 @@
+package migrate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	price "github.com/maohieng/go-price"
+)
+
+func TestMigrator_Price(t *testing.T) {
+	m, err := New(Options{})
+	require.NoError(t, err)
+
+	p, err := m.Price("doc1.amount", 12.34, "EUR")
+	require.NoError(t, err)
+	assert.True(t, p.Equal(price.NewFromInt(1234, 100, "EUR").GetPayable()))
+
+	p, err = m.Price("doc2.amount", 12.345, "EUR")
+	require.NoError(t, err)
+	assert.True(t, p.Equal(price.NewFromInt(1235, 100, "EUR").GetPayable()))
+
+	_, err = m.Price("doc3.amount", math.NaN(), "EUR")
+	assert.Error(t, err)
+
+	report := m.Report()
+	assert.Equal(t, 2, report.Migrated)
+	require.Len(t, report.Adjustments, 1)
+	assert.Equal(t, "doc2.amount", report.Adjustments[0].Field)
+	assert.Equal(t, 12.345, report.Adjustments[0].Original)
+	assert.Equal(t, "0.005", report.Adjustments[0].Difference.Text('f', 3))
+}
+
+func TestMigrator_Options(t *testing.T) {
+	_, err := New(Options{RoundingMode: price.RoundingModeFloor})
+	assert.Error(t, err)
+
+	m, err := New(Options{RoundingMode: price.RoundingModeFloor, Precision: 1})
+	require.NoError(t, err)
+
+	p, err := m.MutablePrice("doc", price.MutablePrice{Amount: 99.9, Currency: "EUR"})
+	require.NoError(t, err)
+	assert.True(t, p.Equal(price.NewFromInt(99, 1, "EUR").GetPayableByRoundingMode(price.RoundingModeFloor, 1)))
+	assert.Len(t, m.Report().Adjustments, 1)
+}
+
+func TestMigrator_Charges(t *testing.T) {
+	m, err := New(Options{})
+	require.NoError(t, err)
+
+	charges, err := m.Charges("order1", []LegacyCharge{
+		{Type: price.ChargeTypeMain, PriceAmount: 10.5, PriceCurrency: "EUR"},
+		{Type: price.ChargeTypeGiftCard, Reference: "GC-1", PriceAmount: 500.7, PriceCurrency: "points", ValueAmount: 5.007, ValueCurrency: "EUR"},
+	})
+	require.NoError(t, err)
+
+	main := charges.GetByTypeForced(price.ChargeTypeMain)
+	assert.True(t, main.Value.Equal(main.Price))
+
+	giftCard := charges.GetByTypeForced(price.ChargeTypeGiftCard)
+	assert.Equal(t, "500", giftCard.Price.Amount().Text('f', 0))
+	assert.Equal(t, "5.01", giftCard.Value.Amount().Text('f', 2))
+
+	report := m.Report()
+	assert.Equal(t, 3, report.Migrated)
+	require.Len(t, report.Adjustments, 2)
+	assert.Equal(t, "order1[1].price", report.Adjustments[0].Field)
+	assert.Equal(t, "order1[1].value", report.Adjustments[1].Field)
+}
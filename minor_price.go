@@ -0,0 +1,117 @@
+package price
+
+import (
+	"errors"
+	"math"
+)
+
+// MinorPrice is a compact immutable price with an int64 amount in the smallest unit of the currency, e.g. 1234 for 12.34 EUR.
+// Add, Sub and Multiply do not allocate, which makes it a fast path for hot loops like cart totals of payable amounts.
+// Operations return an error instead of overflowing, continue with Price (see MinorPrice.Price) for amounts beyond int64
+type MinorPrice struct {
+	units    int64
+	currency string
+}
+
+// NewMinorPrice - factory method, e.g. NewMinorPrice(1234, "EUR") for 12.34 EUR
+func NewMinorPrice(units int64, currency string) MinorPrice {
+	return MinorPrice{
+		units:    units,
+		currency: currency,
+	}
+}
+
+// MinorPriceFrom returns the payable price in minor units, see Price.MinorUnits
+func MinorPriceFrom(p Price) (MinorPrice, error) {
+	units, err := p.MinorUnits()
+	if err != nil {
+		return MinorPrice{currency: p.currency}, err
+	}
+	return NewMinorPrice(units, p.currency), nil
+}
+
+// Price returns the amount as Price
+func (m MinorPrice) Price() Price {
+	return NewFromMinorUnits(m.units, m.currency)
+}
+
+// Units returns the amount in minor units
+func (m MinorPrice) Units() int64 {
+	return m.units
+}
+
+// Currency returns currency
+func (m MinorPrice) Currency() string {
+	return m.currency
+}
+
+// Add the given price to the current price and returns a new price
+func (m MinorPrice) Add(add MinorPrice) (MinorPrice, error) {
+	if m.currency != add.currency {
+		return m, ErrCurrencyMismatch
+	}
+	sum := m.units + add.units
+	if (sum > m.units) != (add.units > 0) {
+		return m, errors.New("sum exceeds the range of minor units")
+	}
+	return NewMinorPrice(sum, m.currency), nil
+}
+
+// Sub the given price from the current price and returns a new price
+func (m MinorPrice) Sub(sub MinorPrice) (MinorPrice, error) {
+	if m.currency != sub.currency {
+		return m, ErrCurrencyMismatch
+	}
+	diff := m.units - sub.units
+	if (diff < m.units) != (sub.units > 0) {
+		return m, errors.New("difference exceeds the range of minor units")
+	}
+	return NewMinorPrice(diff, m.currency), nil
+}
+
+// Multiply returns a new price with the amount multiplied by qty
+func (m MinorPrice) Multiply(qty int64) (MinorPrice, error) {
+	product := m.units * qty
+	if m.units != 0 && (product/m.units != qty || (m.units == -1 && qty == math.MinInt64)) {
+		return m, errors.New("product exceeds the range of minor units")
+	}
+	return NewMinorPrice(product, m.currency), nil
+}
+
+// Equal compares currency and amount
+func (m MinorPrice) Equal(cmp MinorPrice) bool {
+	return m == cmp
+}
+
+// IsZero returns true if the amount is zero
+func (m MinorPrice) IsZero() bool {
+	return m.units == 0
+}
+
+// IsNegative returns true if the amount is lower than zero
+func (m MinorPrice) IsNegative() bool {
+	return m.units < 0
+}
+
+func (m MinorPrice) String() string {
+	return m.Price().String()
+}
+
+// MarshalJSON uses the JSON format of Price
+func (m MinorPrice) MarshalJSON() ([]byte, error) {
+	return m.Price().MarshalJSON()
+}
+
+// UnmarshalJSON reads the JSON format of Price, the amount is rounded to payable minor units
+func (m *MinorPrice) UnmarshalJSON(data []byte) error {
+	var p Price
+	if err := p.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	parsed, err := MinorPriceFrom(p)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,133 @@
+package price_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestMinorPrice_Arithmetic(t *testing.T) {
+	a := price.NewMinorPrice(1234, "EUR")
+	b := price.NewMinorPrice(99, "EUR")
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1333), sum.Units())
+
+	diff, err := b.Sub(a)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1135), diff.Units())
+	assert.True(t, diff.IsNegative())
+
+	product, err := a.Multiply(3)
+	require.NoError(t, err)
+	assert.True(t, product.Equal(price.NewMinorPrice(3702, "EUR")))
+	assert.True(t, product.Price().Equal(price.NewFromInt(3702, 100, "EUR")))
+
+	_, err = a.Add(price.NewMinorPrice(1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestMinorPrice_Overflow(t *testing.T) {
+	maxPrice := price.NewMinorPrice(math.MaxInt64, "EUR")
+	minPrice := price.NewMinorPrice(math.MinInt64, "EUR")
+
+	_, err := maxPrice.Add(price.NewMinorPrice(1, "EUR"))
+	assert.Error(t, err)
+	_, err = minPrice.Sub(price.NewMinorPrice(1, "EUR"))
+	assert.Error(t, err)
+	_, err = maxPrice.Multiply(2)
+	assert.Error(t, err)
+	_, err = price.NewMinorPrice(-1, "EUR").Multiply(math.MinInt64)
+	assert.Error(t, err)
+
+	zero, err := price.NewMinorPrice(0, "EUR").Multiply(math.MinInt64)
+	require.NoError(t, err)
+	assert.True(t, zero.IsZero())
+}
+
+func TestMinorPrice_Conversion(t *testing.T) {
+	m, err := price.MinorPriceFrom(price.NewFromFloat(12.345, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1235), m.Units())
+
+	m, err = price.MinorPriceFrom(price.NewFromInt(1234, 1, "JPY"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), m.Units())
+
+	data, err := json.Marshal(price.NewMinorPrice(1234, "EUR"))
+	require.NoError(t, err)
+	var parsed price.MinorPrice
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, price.NewMinorPrice(1234, "EUR"), parsed)
+}
+
+func BenchmarkPrice_Add(b *testing.B) {
+	p := price.NewFromInt(1234, 100, "EUR")
+	add := price.NewFromInt(99, 100, "EUR")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Add(add)
+	}
+}
+
+func BenchmarkMinorPrice_Add(b *testing.B) {
+	p := price.NewMinorPrice(1234, "EUR")
+	add := price.NewMinorPrice(99, "EUR")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Add(add)
+	}
+}
+
+func BenchmarkPrice_Multiply(b *testing.B) {
+	p := price.NewFromInt(1234, 100, "EUR")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = p.Multiply(3)
+	}
+}
+
+func BenchmarkMinorPrice_Multiply(b *testing.B) {
+	p := price.NewMinorPrice(1234, "EUR")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Multiply(3)
+	}
+}
+
+func BenchmarkPrice_CartTotal(b *testing.B) {
+	items := make([]price.Price, 1000)
+	for i := range items {
+		items[i] = price.NewFromInt(int64(100+i), 100, "EUR")
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		total := price.NewZero("EUR")
+		for _, item := range items {
+			total, _ = total.Add(item.Multiply(2))
+		}
+	}
+}
+
+func BenchmarkMinorPrice_CartTotal(b *testing.B) {
+	items := make([]price.MinorPrice, 1000)
+	for i := range items {
+		items[i] = price.NewMinorPrice(int64(100+i), "EUR")
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		total := price.NewMinorPrice(0, "EUR")
+		for _, item := range items {
+			line, _ := item.Multiply(2)
+			total, _ = total.Add(line)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+)
+
+// NewFromMinorUnits creates a price from an amount in the smallest unit of the currency, e.g. 1234 EUR cents for 12.34 EUR.
+// The smallest unit is the payable precision of the RoundingConfigProvider, so MinorUnits returns the amount again.
+// By default it is the minor unit registered for the currency (see LookupCurrency), whole units for "points" and "miles"
+// and cents for unknown currencies
+func NewFromMinorUnits(amount int64, currency string) Price {
+	return NewFromInt(amount, payablePrecision(currency), currency)
+}
+
+// MinorUnits returns the payable amount in the smallest unit of the currency, e.g. 1234 for 12.34 EUR, 1234 for 1234 JPY
+// or 99 for 99.9 points. The amount is rounded like GetPayable with the rounding mode and precision of the
+// RoundingConfigProvider, an error is returned if it does not fit into an int64
+func (p Price) MinorUnits() (int64, error) {
+	if p.amount.IsInf() {
+		return 0, newDetailedError(ErrNotFinite, "infinite amount cannot be converted to minor units")
+	}
+	mode, precision := p.payableRoundingPrecision()
+	payable := p.GetPayableByRoundingMode(mode, precision)
+
+	amount, _ := payable.amount.Rat(nil)
+	amount.Mul(amount, new(big.Rat).SetInt64(int64(precision)))
+	// the payable amount is a multiple of the minor unit, rounding only removes the binary representation error
+	units := roundRat(amount, RoundingModeHalfUp)
+	if !units.IsInt64() {
+		return 0, errors.New("amount " + units.String() + " exceeds the range of minor units")
+	}
+	return units.Int64(), nil
+}
+
+// payablePrecision returns the precision of the smallest payable unit of the currency, e.g. 100 for cents
+func payablePrecision(currency string) int {
+	return roundingConfigFrom(currentRoundingConfigProvider(), currency).Precision
+}
+
+// fractionDigits returns the decimal digits of a precision, false if it is not a power of ten
+func fractionDigits(precision int) (int, bool) {
+	digits := 0
+	for ; precision > 1 && precision%10 == 0; precision /= 10 {
+		digits++
+	}
+	return digits, precision == 1
+}
@@ -0,0 +1,64 @@
+package price
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromMinorUnits(t *testing.T) {
+	assert.Equal(t, "12.34", NewFromMinorUnits(1234, "EUR").Amount().Text('f', 2))
+	assert.Equal(t, "1234", NewFromMinorUnits(1234, "JPY").Amount().Text('f', 0))
+	assert.Equal(t, "1.234", NewFromMinorUnits(1234, "BHD").Amount().Text('f', 3))
+	assert.Equal(t, "12.34", NewFromMinorUnits(1234, "unknown").Amount().Text('f', 2))
+	assert.Equal(t, "99", NewFromMinorUnits(99, "points").Amount().Text('f', 0))
+}
+
+func TestPrice_MinorUnitsPayable(t *testing.T) {
+	points := NewFromFloat(99.9, "points")
+	units, err := points.MinorUnits()
+	require.NoError(t, err)
+	assert.Equal(t, int64(99), units)
+	assert.True(t, NewFromMinorUnits(units, "points").Equal(points.GetPayable()))
+
+	SetRoundingConfigProvider(StaticRoundingConfig{"EUR": {Mode: RoundingModeFloor, Precision: 10}})
+	t.Cleanup(func() { SetRoundingConfigProvider(nil) })
+	eur := NewFromFloat(12.39, "EUR")
+	units, err = eur.MinorUnits()
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), units)
+	assert.True(t, NewFromMinorUnits(units, "EUR").Equal(eur.GetPayable()))
+}
+
+func TestPrice_MinorUnits(t *testing.T) {
+	tests := []struct {
+		price    Price
+		expected int64
+	}{
+		{price: NewFromFloat(12.34, "EUR"), expected: 1234},
+		{price: NewFromFloat(12.345, "EUR"), expected: 1235},
+		{price: NewFromFloat(-12.34, "EUR"), expected: -1234},
+		{price: NewFromFloat(1234.5, "JPY"), expected: 1235},
+		{price: NewFromFloat(1.2345, "KWD"), expected: 1235},
+		{price: NewZero("EUR"), expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.price.String(), func(t *testing.T) {
+			units, err := tt.price.MinorUnits()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, units)
+
+			assert.True(t, NewFromMinorUnits(units, tt.price.Currency()).Equal(NewFromMinorUnits(tt.expected, tt.price.Currency())))
+		})
+	}
+
+	_, err := NewFromFloat(math.MaxInt64, "EUR").MinorUnits()
+	assert.Error(t, err)
+
+	units, err := NewFromMinorUnits(math.MaxInt64, "JPY").MinorUnits()
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), units)
+}
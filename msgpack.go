@@ -0,0 +1,233 @@
+package price
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// MessagePack formats used by MarshalMsgpack and UnmarshalMsgpack
+const (
+	msgpackNil     byte = 0xc0
+	msgpackFloat32 byte = 0xca
+	msgpackFloat64 byte = 0xcb
+	msgpackUint8   byte = 0xcc
+	msgpackUint16  byte = 0xcd
+	msgpackUint32  byte = 0xce
+	msgpackUint64  byte = 0xcf
+	msgpackInt8    byte = 0xd0
+	msgpackInt16   byte = 0xd1
+	msgpackInt32   byte = 0xd2
+	msgpackInt64   byte = 0xd3
+	msgpackStr8    byte = 0xd9
+	msgpackStr16   byte = 0xda
+	msgpackStr32   byte = 0xdb
+	msgpackMap16   byte = 0xde
+	msgpackMap32   byte = 0xdf
+)
+
+// MarshalMsgpack implements the msgpack.Marshaler interface of vmihailenco/msgpack without depending on it.
+// The price is encoded as map {"amount": "12.34", "currency": "EUR"} like the JSON format,
+// so no extension has to be registered
+func (p Price) MarshalMsgpack() ([]byte, error) {
+	if p.amount.IsInf() {
+		return nil, ErrNotFinite
+	}
+	data := []byte{0x82}
+	data = msgpackString(data, "amount")
+	data = msgpackString(data, p.canonicalAmount())
+	data = msgpackString(data, "currency")
+	return msgpackString(data, p.currency), nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface of vmihailenco/msgpack without depending on it.
+// The amount may be a decimal string, an integer or a float, nil is read as zero price
+func (p *Price) UnmarshalMsgpack(data []byte) error {
+	r := &msgpackReader{data: data}
+	if len(data) == 1 && data[0] == msgpackNil {
+		*p = NewZero("")
+		return nil
+	}
+	pairs, err := r.mapLen()
+	if err != nil {
+		return err
+	}
+
+	result := NewZero("")
+	var currency string
+	for i := 0; i < pairs; i++ {
+		key, err := r.str()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "amount":
+			if result, err = r.amount(); err != nil {
+				return err
+			}
+		case "currency":
+			if currency, err = r.str(); err != nil {
+				return err
+			}
+		default:
+			return errors.New("unknown msgpack price field " + key)
+		}
+	}
+	if r.pos != len(data) {
+		return errors.New("unexpected data after msgpack price")
+	}
+
+	result.currency = currency
+	*p = result
+	return nil
+}
+
+func msgpackString(data []byte, s string) []byte {
+	n := len(s)
+	switch {
+	case n < 32:
+		data = append(data, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		data = append(data, msgpackStr8, byte(n))
+	case n <= math.MaxUint16:
+		data = append(data, msgpackStr16, byte(n>>8), byte(n))
+	default:
+		data = append(data, msgpackStr32, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(data, s...)
+}
+
+// msgpackReader reads the MessagePack values of a price
+type msgpackReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *msgpackReader) next(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data)-r.pos {
+		return nil, errors.New("unexpected end of msgpack data")
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// uintN reads a big endian unsigned integer of n bytes
+func (r *msgpackReader) uintN(n int) (uint64, error) {
+	b, err := r.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+func (r *msgpackReader) mapLen() (int, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+	var n uint64
+	switch {
+	case b[0]&0xf0 == 0x80:
+		return int(b[0] & 0x0f), nil
+	case b[0] == msgpackMap16:
+		n, err = r.uintN(2)
+	case b[0] == msgpackMap32:
+		n, err = r.uintN(4)
+	default:
+		return 0, errors.New("price must be a msgpack map")
+	}
+	return int(n), err
+}
+
+func (r *msgpackReader) str() (string, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return "", err
+	}
+	return r.strOf(b[0])
+}
+
+func (r *msgpackReader) strOf(format byte) (string, error) {
+	var (
+		n   uint64
+		err error
+	)
+	switch format {
+	case msgpackStr8:
+		n, err = r.uintN(1)
+	case msgpackStr16:
+		n, err = r.uintN(2)
+	case msgpackStr32:
+		n, err = r.uintN(4)
+	default:
+		if format&0xe0 != 0xa0 {
+			return "", errors.New("expected msgpack string")
+		}
+		n = uint64(format & 0x1f)
+	}
+	if err != nil {
+		return "", err
+	}
+	b, err := r.next(int(n))
+	return string(b), err
+}
+
+// amount reads a decimal string, integer or float
+func (r *msgpackReader) amount() (Price, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return Price{}, err
+	}
+
+	format := b[0]
+	switch {
+	case format <= 0x7f:
+		return NewFromBigFloat(*new(big.Float).SetInt64(int64(format)), ""), nil
+	case format >= 0xe0:
+		return NewFromBigFloat(*new(big.Float).SetInt64(int64(int8(format))), ""), nil
+	case format == msgpackNil:
+		return NewZero(""), nil
+	case format == msgpackFloat32:
+		bits, err := r.uintN(4)
+		if err != nil {
+			return Price{}, err
+		}
+		return NewFromFloatChecked(float64(math.Float32frombits(uint32(bits))), "")
+	case format == msgpackFloat64:
+		bits, err := r.uintN(8)
+		if err != nil {
+			return Price{}, err
+		}
+		return NewFromFloatChecked(math.Float64frombits(bits), "")
+	case format >= msgpackUint8 && format <= msgpackUint64:
+		v, err := r.uintN(1 << (format - msgpackUint8))
+		if err != nil {
+			return Price{}, err
+		}
+		return NewFromBigFloat(*new(big.Float).SetUint64(v), ""), nil
+	case format >= msgpackInt8 && format <= msgpackInt64:
+		size := 1 << (format - msgpackInt8)
+		v, err := r.uintN(size)
+		if err != nil {
+			return Price{}, err
+		}
+		// sign extension of the big endian value
+		shift := 64 - 8*size
+		return NewFromBigFloat(*new(big.Float).SetInt64(int64(v<<shift) >> shift), ""), nil
+	}
+
+	s, err := r.strOf(format)
+	if err != nil {
+		return Price{}, errors.New("unsupported msgpack amount")
+	}
+	amount, _, err := new(big.Float).Parse(s, 10)
+	if err != nil {
+		return Price{}, errors.New("invalid amount " + s)
+	}
+	return NewFromBigFloat(*amount, ""), nil
+}
@@ -0,0 +1,118 @@
+package price
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_MarshalMsgpack(t *testing.T) {
+	data, err := NewFromInt(1234, 100, "EUR").MarshalMsgpack()
+	require.NoError(t, err)
+	// {"amount": "12.34", "currency": "EUR"}
+	assert.Equal(t, "82a6616d6f756e74a531322e3334a863757272656e6379a3455552", hex.EncodeToString(data))
+
+	_, err = NewFromBigFloat(*new(big.Float).SetInf(true), "EUR").MarshalMsgpack()
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestPrice_UnmarshalMsgpack(t *testing.T) {
+	const currency = "a863757272656e6379a3455552"
+	tests := []struct {
+		name string
+		data string
+		want Price
+	}{
+		{
+			name: "string",
+			data: "82a6616d6f756e74a531322e3334" + currency,
+			want: NewFromInt(1234, 100, "EUR"),
+		},
+		{
+			name: "positive fixint",
+			data: "82a6616d6f756e7405" + currency,
+			want: NewFromInt(5, 1, "EUR"),
+		},
+		{
+			name: "negative fixint",
+			data: "82a6616d6f756e74fb" + currency,
+			want: NewFromInt(-5, 1, "EUR"),
+		},
+		{
+			name: "int16",
+			data: "82a6616d6f756e74d1fc18" + currency,
+			want: NewFromInt(-1000, 1, "EUR"),
+		},
+		{
+			name: "uint32",
+			data: "82a6616d6f756e74ce000186a0" + currency,
+			want: NewFromInt(100000, 1, "EUR"),
+		},
+		{
+			name: "float64",
+			data: "82a6616d6f756e74cb3ff8000000000000" + currency,
+			want: NewFromInt(15, 10, "EUR"),
+		},
+		{
+			name: "float32",
+			data: "82a6616d6f756e74ca3fc00000" + currency,
+			want: NewFromInt(15, 10, "EUR"),
+		},
+		{
+			name: "map16 and str8",
+			data: "de0002a6616d6f756e74d90531322e3334" + currency,
+			want: NewFromInt(1234, 100, "EUR"),
+		},
+		{
+			name: "nil",
+			data: "c0",
+			want: NewZero(""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+			var p Price
+			require.NoError(t, p.UnmarshalMsgpack(data))
+			assert.True(t, tt.want.LikelyEqual(p), "got %s", p.String())
+			assert.Equal(t, tt.want.Currency(), p.Currency())
+		})
+	}
+
+	invalid := []string{
+		"",
+		"90",
+		"81a6616d6f756e74",
+		"81a6616d6f756e74a5313232",
+		"81a570726963650a",
+		"81a6616d6f756e74a3616263",
+		"81a6616d6f756e74c3",
+		"81a6616d6f756e740501",
+	}
+	for _, data := range invalid {
+		b, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		var p Price
+		assert.Error(t, p.UnmarshalMsgpack(b), data)
+	}
+}
+
+func TestPrice_MsgpackRoundTrip(t *testing.T) {
+	for _, p := range []Price{
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-1, 1000, "JPY"),
+		NewFromFloat(0.1, "USD"),
+		NewZero(""),
+	} {
+		data, err := p.MarshalMsgpack()
+		require.NoError(t, err)
+		var decoded Price
+		require.NoError(t, decoded.UnmarshalMsgpack(data))
+		assert.Equal(t, p.canonicalAmount(), decoded.canonicalAmount())
+		assert.Equal(t, p.Currency(), decoded.Currency())
+	}
+}
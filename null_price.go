@@ -0,0 +1,80 @@
+package price
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullPrice is an optional price like sql.NullString, e.g. for a strike-through price.
+// It is stored as NULL and encoded as JSON null if Valid is false
+type NullPrice struct {
+	Price Price
+	// Valid is true if Price is set
+	Valid bool
+}
+
+// NewNullPrice returns a valid NullPrice of p
+func NewNullPrice(p Price) NullPrice {
+	return NullPrice{
+		Price: p,
+		Valid: true,
+	}
+}
+
+// Ptr returns a pointer to the price or nil if it is not valid
+func (n NullPrice) Ptr() *Price {
+	if !n.Valid {
+		return nil
+	}
+	p := n.Price
+	return &p
+}
+
+// Value implements driver.Valuer, see Price.Value
+func (n NullPrice) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Price.Value()
+}
+
+// Scan implements sql.Scanner, see Price.Scan. NULL sets Valid to false
+func (n *NullPrice) Scan(value interface{}) error {
+	if value == nil {
+		*n = NullPrice{Price: NewZero(n.Price.currency)}
+		return nil
+	}
+	if err := n.Price.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements interface required by json marshal, an invalid price is encoded as null
+func (n NullPrice) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Price.MarshalJSON()
+}
+
+// UnmarshalJSON implements encode Unmarshaler, null sets Valid to false
+func (n *NullPrice) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = NullPrice{}
+		return nil
+	}
+	var p Price
+	if err := p.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NewNullPrice(p)
+	return nil
+}
+
+// GormDataType implements the schema.GormDataTypeInterface of gorm.io, see Price.GormDataType
+func (n NullPrice) GormDataType() string {
+	return gormDataTypeJSON
+}
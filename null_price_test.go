@@ -0,0 +1,57 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullPrice_JSON(t *testing.T) {
+	type product struct {
+		Price       Price     `json:"price"`
+		StrikePrice NullPrice `json:"strikePrice"`
+	}
+
+	data, err := json.Marshal(product{Price: NewFromInt(999, 100, "EUR")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"9.99","currency":"EUR"},"strikePrice":null}`, string(data))
+
+	var decoded product
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.False(t, decoded.StrikePrice.Valid)
+	assert.Nil(t, decoded.StrikePrice.Ptr())
+
+	data, err = json.Marshal(product{Price: NewFromInt(999, 100, "EUR"), StrikePrice: NewNullPrice(NewFromInt(1299, 100, "EUR"))})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"9.99","currency":"EUR"},"strikePrice":{"amount":"12.99","currency":"EUR"}}`, string(data))
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.StrikePrice.Valid)
+	assert.True(t, NewFromInt(1299, 100, "EUR").LikelyEqual(*decoded.StrikePrice.Ptr()))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"strikePrice":{"amount":"abc"}}`), &decoded))
+}
+
+func TestNullPrice_ValueScan(t *testing.T) {
+	value, err := NullPrice{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = NewNullPrice(NewFromInt(1234, 100, "EUR")).Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.34","currency":"EUR"}`, string(value.([]byte)))
+
+	var scanned NullPrice
+	require.NoError(t, scanned.Scan(value))
+	assert.True(t, scanned.Valid)
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(scanned.Price))
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+	assert.True(t, scanned.Price.IsZero())
+
+	assert.Error(t, scanned.Scan(true))
+	assert.False(t, scanned.Valid)
+}
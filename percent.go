@@ -0,0 +1,164 @@
+package price
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Percent is an exact percentage (e.g. 19 or 12.5 percent) - it is immutable
+// DevHint: internally a big.Rat is used, so values like 12.5 or 0.1 percent are represented without float errors
+type Percent struct {
+	rat big.Rat
+}
+
+// NewPercent creates a Percent from a whole number, e.g. NewPercent(19) for 19%
+func NewPercent(percent int64) Percent {
+	return Percent{rat: *new(big.Rat).SetInt64(percent)}
+}
+
+// NewPercentFromFloat creates a Percent from a float, the shortest decimal representation of the float is used
+// so that NewPercentFromFloat(0.1) is exactly 0.1%. NaN and ±Inf cannot be represented and result in 0%,
+// use NewPercentFromFloatChecked for untrusted input
+func NewPercentFromFloat(percent float64) Percent {
+	rat, ok := new(big.Rat).SetString(strconv.FormatFloat(percent, 'f', -1, 64))
+	if !ok {
+		return Percent{}
+	}
+	return Percent{rat: *rat}
+}
+
+// NewPercentFromFloatChecked creates a Percent like NewPercentFromFloat, NaN and ±Inf return ErrNotFinite
+func NewPercentFromFloatChecked(percent float64) (Percent, error) {
+	if math.IsNaN(percent) || math.IsInf(percent, 0) {
+		return Percent{}, newDetailedError(ErrNotFinite, "percent must be a finite number")
+	}
+	return NewPercentFromFloat(percent), nil
+}
+
+// NewPercentFromBigFloat creates a Percent from a big.Float, ±Inf results in 0%, use NewPercentFromBigFloatChecked
+// for untrusted input
+func NewPercentFromBigFloat(percent big.Float) Percent {
+	if percent.IsInf() {
+		return Percent{}
+	}
+	rat, _ := percent.Rat(nil)
+	return Percent{rat: *rat}
+}
+
+// NewPercentFromBigFloatChecked creates a Percent like NewPercentFromBigFloat, ±Inf returns ErrNotFinite
+func NewPercentFromBigFloatChecked(percent big.Float) (Percent, error) {
+	if percent.IsInf() {
+		return Percent{}, newDetailedError(ErrNotFinite, "percent must be a finite number")
+	}
+	return NewPercentFromBigFloat(percent), nil
+}
+
+// NewPercentFromBasisPoints creates a Percent from basis points, e.g. 1250 for 12.5%
+func NewPercentFromBasisPoints(bp int64) Percent {
+	return Percent{rat: *big.NewRat(bp, 100)}
+}
+
+// ParsePercent parses a decimal string like "12.5" or "12.5%"
+func ParsePercent(s string) (Percent, error) {
+	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	rat, ok := new(big.Rat).SetString(trimmed)
+	if !ok || trimmed == "" {
+		return Percent{}, errors.New("invalid percent " + s)
+	}
+	return Percent{rat: *rat}, nil
+}
+
+// String returns the percentage as decimal string with a percent sign, e.g. "12.5%"
+func (p Percent) String() string {
+	return p.decimalString() + "%"
+}
+
+// MarshalText implements encoding.TextMarshaler, the percentage is encoded as decimal string without percent sign
+func (p Percent) MarshalText() ([]byte, error) {
+	return []byte(p.decimalString()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *Percent) UnmarshalText(text []byte) error {
+	parsed, err := ParsePercent(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// Rat returns a copy of the percentage value as big.Rat (19% is returned as 19)
+func (p Percent) Rat() *big.Rat {
+	return new(big.Rat).Set(&p.rat)
+}
+
+// BigFloat returns the percentage value as big.Float (19% is returned as 19)
+func (p Percent) BigFloat() *big.Float {
+	return new(big.Float).SetRat(&p.rat)
+}
+
+// Float64 returns the nearest float64 value of the percentage
+func (p Percent) Float64() float64 {
+	f, _ := p.rat.Float64()
+	return f
+}
+
+// IsZero returns true if the percentage is zero
+func (p Percent) IsZero() bool {
+	return p.rat.Sign() == 0
+}
+
+// Equal compares the percentages exact
+func (p Percent) Equal(cmp Percent) bool {
+	return p.rat.Cmp(&cmp.rat) == 0
+}
+
+// Of returns the share of the given price, e.g. 10% of 12.00 EUR is 1.20 EUR
+func (p Percent) Of(price Price) Price {
+	return price.mulRat(new(big.Rat).Quo(&p.rat, big.NewRat(100, 1)))
+}
+
+// decimalString formats the percentage as exact decimal, repeating decimals are cut after 10 digits
+func (p Percent) decimalString() string {
+	s, _ := ratDecimalString(&p.rat, 10)
+	return s
+}
+
+// ratDecimalString formats r as exact decimal, repeating decimals are cut after maxDigits digits.
+// The second return value is false if the decimal had to be cut
+func ratDecimalString(r *big.Rat, maxDigits int) (string, bool) {
+	if r.IsInt() {
+		return r.Num().String(), true
+	}
+
+	digits, exact := maxDigits, false
+	denom := new(big.Int).Set(r.Denom())
+	twos, fives := 0, 0
+	two, five := big.NewInt(2), big.NewInt(5)
+	mod := new(big.Int)
+	for mod.Mod(denom, two).Sign() == 0 {
+		denom.Quo(denom, two)
+		twos++
+	}
+	for mod.Mod(denom, five).Sign() == 0 {
+		denom.Quo(denom, five)
+		fives++
+	}
+	if denom.IsInt64() && denom.Int64() == 1 {
+		exact = true
+		digits = twos
+		if fives > digits {
+			digits = fives
+		}
+	}
+
+	s := r.FloatString(digits)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s, exact
+}
@@ -0,0 +1,100 @@
+package price
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{input: "19", expected: "19%"},
+		{input: "12.5", expected: "12.5%"},
+		{input: " 2.75% ", expected: "2.75%"},
+		{input: "-3", expected: "-3%"},
+		{input: "1/3", expected: "0.3333333333%"},
+		{input: "", wantErr: true},
+		{input: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePercent(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got.String())
+		})
+	}
+}
+
+func TestPercent_Constructors(t *testing.T) {
+	assert.True(t, NewPercent(19).Equal(NewPercentFromFloat(19)))
+	assert.True(t, NewPercentFromBasisPoints(1250).Equal(NewPercentFromFloat(12.5)))
+	assert.Equal(t, "0.1%", NewPercentFromFloat(0.1).String())
+	assert.True(t, NewPercentFromBigFloat(*big.NewFloat(7)).Equal(NewPercent(7)))
+	assert.True(t, Percent{}.IsZero())
+}
+
+func TestPercent_CheckedConstructors(t *testing.T) {
+	percent, err := NewPercentFromFloatChecked(12.5)
+	require.NoError(t, err)
+	assert.True(t, percent.Equal(NewPercentFromBasisPoints(1250)))
+	percent, err = NewPercentFromBigFloatChecked(*big.NewFloat(7))
+	require.NoError(t, err)
+	assert.True(t, percent.Equal(NewPercent(7)))
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		// the unchecked constructor documents the fallback to 0%
+		assert.True(t, NewPercentFromFloat(f).IsZero())
+		_, err = NewPercentFromFloatChecked(f)
+		assert.ErrorIs(t, err, ErrNotFinite)
+	}
+	_, err = NewPercentFromBigFloatChecked(*new(big.Float).SetInf(true))
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestPercent_Of(t *testing.T) {
+	price := NewFromInt(1200, 100, "EUR")
+	share := NewPercentFromFloat(12.5).Of(price)
+	assert.True(t, share.Equal(NewFromInt(150, 100, "EUR")))
+}
+
+func TestPercent_JSON(t *testing.T) {
+	type wrapper struct {
+		Rate Percent `json:"rate"`
+	}
+
+	data, err := json.Marshal(wrapper{Rate: NewPercentFromFloat(2.75)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"rate":"2.75"}`, string(data))
+
+	var w wrapper
+	require.NoError(t, json.Unmarshal(data, &w))
+	assert.True(t, w.Rate.Equal(NewPercentFromBasisPoints(275)))
+}
+
+func TestPrice_PercentVariants(t *testing.T) {
+	price := NewFromInt(10000, 100, "EUR")
+	pct := NewPercent(19)
+
+	assert.True(t, price.TaxedBy(pct).Equal(NewFromInt(119, 1, "EUR")))
+	assert.True(t, price.TaxFromNetBy(pct).Equal(NewFromInt(19, 1, "EUR")))
+	assert.True(t, NewFromInt(119, 1, "EUR").TaxFromGrossBy(pct).Equal(NewFromInt(19, 1, "EUR")))
+	assert.True(t, price.DiscountedBy(NewPercentFromFloat(12.5)).Equal(NewFromInt(8750, 100, "EUR")))
+}
+
+func TestDiscount_Percent(t *testing.T) {
+	discount := Discount{Percentage: 15}
+	assert.True(t, discount.Percent().Equal(NewPercent(15)))
+}
@@ -0,0 +1,48 @@
+package price
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// PGNumeric holds the fields of pgtype.Numeric of pgx, the amount is Int * 10^Exp.
+// It avoids a dependency on pgx, map the amount to a NUMERIC column and the currency to a separate column like this:
+//
+//	pn, err := p.ToPGNumeric()
+//	numeric := pgtype.Numeric{Int: pn.Int, Exp: pn.Exp, Valid: true}
+//	_, err = conn.Exec(ctx, "INSERT INTO orders (total, currency) VALUES ($1, $2)", numeric, p.Currency())
+//
+//	var numeric pgtype.Numeric
+//	var currency string
+//	err := conn.QueryRow(ctx, "SELECT total, currency FROM orders").Scan(&numeric, &currency)
+//	p, err := price.FromPGNumeric(price.PGNumeric{Int: numeric.Int, Exp: numeric.Exp}, currency)
+type PGNumeric struct {
+	// Int is the coefficient of the amount
+	Int *big.Int
+	// Exp is the decimal exponent of the amount
+	Exp int32
+}
+
+// ToPGNumeric returns the exact amount as PGNumeric, PostgreSQL stores it without rounding
+func (p Price) ToPGNumeric() (PGNumeric, error) {
+	if p.amount.IsInf() {
+		return PGNumeric{}, ErrNotFinite
+	}
+	coefficient, exp := p.decimalParts()
+	if exp < math.MinInt32 {
+		return PGNumeric{}, errors.New("amount exceeds the range of NUMERIC")
+	}
+	return PGNumeric{
+		Int: coefficient,
+		Exp: int32(exp),
+	}, nil
+}
+
+// FromPGNumeric creates a price from a NUMERIC value, a nil Int is read as zero
+func FromPGNumeric(n PGNumeric, currency string) (Price, error) {
+	if n.Int == nil {
+		return NewZero(currency), nil
+	}
+	return newFromDecimalParts(n.Int, int(n.Exp), currency)
+}
@@ -0,0 +1,47 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_ToPGNumeric(t *testing.T) {
+	n, err := NewFromInt(-1234, 100, "EUR").ToPGNumeric()
+	require.NoError(t, err)
+	assert.Equal(t, "-1234", n.Int.String())
+	assert.Equal(t, int32(-2), n.Exp)
+
+	n, err = NewFromInt(500, 1, "EUR").ToPGNumeric()
+	require.NoError(t, err)
+	assert.Equal(t, "500", n.Int.String())
+	assert.Equal(t, int32(0), n.Exp)
+
+	_, err = NewFromBigFloat(*new(big.Float).SetInf(false), "EUR").ToPGNumeric()
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestFromPGNumeric(t *testing.T) {
+	p, err := FromPGNumeric(PGNumeric{Int: big.NewInt(1234), Exp: -2}, "EUR")
+	require.NoError(t, err)
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(p))
+	assert.Equal(t, "EUR", p.Currency())
+
+	p, err = FromPGNumeric(PGNumeric{Int: big.NewInt(5), Exp: 3}, "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, "5000", p.canonicalAmount())
+
+	p, err = FromPGNumeric(PGNumeric{}, "EUR")
+	require.NoError(t, err)
+	assert.True(t, p.IsZero())
+
+	for _, original := range []Price{NewFromFloat(0.1, "USD"), NewFromInt(-7, 1000, "EUR"), NewZero("EUR")} {
+		n, err := original.ToPGNumeric()
+		require.NoError(t, err)
+		p, err := FromPGNumeric(n, original.Currency())
+		require.NoError(t, err)
+		assert.Equal(t, original.canonicalAmount(), p.canonicalAmount())
+	}
+}
@@ -0,0 +1,82 @@
+package price
+
+import "errors"
+
+type (
+	// PaymentMethod is a way to pay (part of) a total, used by the SplitPlanner
+	PaymentMethod struct {
+		// Type of the resulting charge, e.g. ChargeTypeGiftCard
+		Type string
+		// Reference of the resulting charge, e.g. the gift card number
+		Reference string
+		// Limit is the maximum amount the method can cover (e.g. the gift card balance), nil means unlimited
+		Limit *Price
+	}
+
+	// SplitPlanner distributes a total over payment methods in their preferred order
+	SplitPlanner struct {
+		// Methods in the preferred order, each method covers as much of the remaining total as its limit allows
+		Methods []PaymentMethod
+		// Converter used to compute the charge values, nil means the total is already in the base currency
+		Converter Converter
+	}
+)
+
+// Plan returns Charges which exactly cover the payable total.
+// Limits are rounded down to payable amounts so a method is never charged more than its limit.
+// An error is returned if the methods cannot cover the total.
+func (s SplitPlanner) Plan(total Price) (Charges, error) {
+	if total.IsNegative() {
+		return Charges{}, errors.New("total must not be negative")
+	}
+
+	remaining := total.GetPayable()
+	charges := Charges{}
+	for _, method := range s.Methods {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		amount := remaining
+		if method.Limit != nil {
+			limit, err := s.payableLimit(total, *method.Limit)
+			if err != nil {
+				return Charges{}, err
+			}
+			if limit.IsLessThen(amount) {
+				amount = limit
+			}
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		charge, err := NewCharge(method.Type, method.Reference, amount, s.Converter)
+		if err != nil {
+			return Charges{}, err
+		}
+		charges = charges.AddCharge(charge)
+
+		remaining, err = remaining.Sub(amount)
+		if err != nil {
+			return Charges{}, err
+		}
+	}
+
+	if remaining.IsPositive() {
+		return Charges{}, errors.New("payment methods cannot cover the total, remaining " + remaining.Display())
+	}
+	return charges, nil
+}
+
+// payableLimit rounds the limit down to the payable precision of the total
+func (s SplitPlanner) payableLimit(total Price, limit Price) (Price, error) {
+	if limit.currency != total.currency {
+		if !limit.IsZero() {
+			return Price{}, newDetailedError(ErrCurrencyMismatch, "limit currency "+limit.currency+" does not match total currency "+total.currency)
+		}
+		return NewZero(total.currency), nil
+	}
+	_, precision := total.payableRoundingPrecision()
+	return limit.GetPayableByRoundingMode(RoundingModeFloor, precision), nil
+}
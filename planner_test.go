@@ -0,0 +1,79 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func limit(p Price) *Price {
+	return &p
+}
+
+func TestSplitPlanner_Plan(t *testing.T) {
+	planner := SplitPlanner{
+		Methods: []PaymentMethod{
+			{Type: ChargeTypeGiftCard, Reference: "GC-1", Limit: limit(NewFromInt(20, 1, "EUR"))},
+			{Type: ChargeTypeGiftCard, Reference: "GC-2", Limit: limit(NewFromFloat(5.559, "EUR"))},
+			{Type: ChargeTypeMain},
+		},
+	}
+
+	t.Run("methods are used in order", func(t *testing.T) {
+		charges, err := planner.Plan(NewFromFloat(49.999, "EUR"))
+		require.NoError(t, err)
+
+		gc1 := charges.GetByChargeQualifierForced(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"})
+		gc2 := charges.GetByChargeQualifierForced(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-2"})
+		main := charges.GetByChargeQualifierForced(ChargeQualifier{Type: ChargeTypeMain})
+		assert.Equal(t, "20.00", gc1.Price.Amount().Text('f', 2))
+		assert.Equal(t, "5.55", gc2.Price.Amount().Text('f', 2), "limit is rounded down")
+		assert.Equal(t, "24.45", main.Price.Amount().Text('f', 2))
+		assert.True(t, main.Value.Equal(main.Price))
+
+		sum, err := SumAll(gc1.Price, gc2.Price, main.Price)
+		require.NoError(t, err)
+		assert.True(t, sum.GetPayable().Equal(NewFromFloat(49.999, "EUR").GetPayable()))
+	})
+
+	t.Run("first method covers everything", func(t *testing.T) {
+		charges, err := planner.Plan(NewFromInt(1250, 100, "EUR"))
+		require.NoError(t, err)
+		assert.Len(t, charges.GetAllCharges(), 1)
+		assert.True(t, charges.HasType(ChargeTypeGiftCard))
+	})
+
+	t.Run("zero total", func(t *testing.T) {
+		charges, err := planner.Plan(NewZero("EUR"))
+		require.NoError(t, err)
+		assert.Empty(t, charges.GetAllCharges())
+	})
+
+	t.Run("not covered", func(t *testing.T) {
+		limited := SplitPlanner{Methods: planner.Methods[:2]}
+		_, err := limited.Plan(NewFromInt(30, 1, "EUR"))
+		assert.Error(t, err)
+	})
+
+	t.Run("negative total", func(t *testing.T) {
+		_, err := planner.Plan(NewFromInt(-30, 1, "EUR"))
+		assert.Error(t, err)
+	})
+
+	t.Run("limit currency mismatch", func(t *testing.T) {
+		mismatch := SplitPlanner{Methods: []PaymentMethod{{Type: ChargeTypeGiftCard, Limit: limit(NewFromInt(5, 1, "USD"))}}}
+		_, err := mismatch.Plan(NewFromInt(30, 1, "EUR"))
+		assert.Error(t, err)
+	})
+
+	t.Run("values computed by converter", func(t *testing.T) {
+		converted := SplitPlanner{
+			Methods:   []PaymentMethod{{Type: ChargeTypeMain}},
+			Converter: fixedRateConverter{rate: 0.5, currency: "EUR"},
+		}
+		charges, err := converted.Plan(NewFromInt(30, 1, "USD"))
+		require.NoError(t, err)
+		assert.Equal(t, "15.00", charges.GetByTypeForced(ChargeTypeMain).Value.Amount().Text('f', 2))
+	})
+}
@@ -1,11 +1,13 @@
 package price
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 )
 
@@ -25,16 +27,19 @@ type (
 
 const (
 	// RoundingModeFloor use if you want to cut (round down)
-	RoundingModeFloor = "floor"
+	RoundingModeFloor RoundingMode = "floor"
 	// RoundingModeCeil use if you want to round up always
-	RoundingModeCeil = "ceil"
+	RoundingModeCeil RoundingMode = "ceil"
 	// RoundingModeHalfUp round up if the discarded fraction is ≥ 0.5, otherwise round down. Default for GetPayable()
-	RoundingModeHalfUp = "halfup"
+	RoundingModeHalfUp RoundingMode = "halfup"
 	// RoundingModeHalfDown round up if the discarded fraction is > 0.5, otherwise round down.
-	RoundingModeHalfDown = "halfdown"
+	RoundingModeHalfDown RoundingMode = "halfdown"
+	// RoundingModeHalfEven round to the nearest neighbor, if both neighbors are equidistant round to the even one (banker's rounding)
+	RoundingModeHalfEven RoundingMode = "halfeven"
 )
 
 // NewFromFloat - factory method
+// NaN panics like big.NewFloat, use NewFromFloatChecked for untrusted input
 func NewFromFloat(amount float64, currency string) Price {
 	return Price{
 		amount:   *big.NewFloat(amount),
@@ -42,6 +47,14 @@ func NewFromFloat(amount float64, currency string) Price {
 	}
 }
 
+// NewFromFloatChecked - factory method returning an error for NaN and ±Inf
+func NewFromFloatChecked(amount float64, currency string) (Price, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return NewZero(currency), ErrNotFinite
+	}
+	return NewFromFloat(amount, currency), nil
+}
+
 // NewFromBigFloat - factory method
 func NewFromBigFloat(amount big.Float, currency string) Price {
 	return Price{
@@ -50,6 +63,14 @@ func NewFromBigFloat(amount big.Float, currency string) Price {
 	}
 }
 
+// NewFromBigFloatChecked - factory method returning an error for ±Inf
+func NewFromBigFloatChecked(amount big.Float, currency string) (Price, error) {
+	if amount.IsInf() {
+		return NewZero(currency), ErrNotFinite
+	}
+	return NewFromBigFloat(amount, currency), nil
+}
+
 // NewZero Zero price
 func NewZero(currency string) Price {
 	return Price{
@@ -75,11 +96,36 @@ func NewFromInt(amount int64, precision int, currency string) Price {
 }
 
 func (p Price) String() string {
-	bytes, _ := p.MarshalText()
+	bytes, _ := p.marshalJSONText()
 	return string(bytes)
 }
 
+// Display returns a human-readable representation of the payable price, e.g. "12.34 EUR".
+// Use it for logs and templates, String and MarshalText return the wire format
+func (p Price) Display() string {
+	mode, precision := p.payableRoundingPrecision()
+	digits := 0
+	for factor := 1; factor < precision; factor *= 10 {
+		digits++
+	}
+
+	amount := p.GetPayableByRoundingMode(mode, precision).Amount().Text('f', digits)
+	if p.currency == "" {
+		return amount
+	}
+	return amount + " " + p.currency
+}
+
+// MarshalText returns the JSON format of MarshalJSON, or the plain text format of EncodeText
+// if enabled with SetTextFormat, e.g. for URL query parameters and CSV columns
 func (p Price) MarshalText() (text []byte, err error) {
+	if currentTextFormat() == TextFormatPlain {
+		return []byte(EncodeText(p)), nil
+	}
+	return p.marshalJSONText()
+}
+
+func (p Price) marshalJSONText() ([]byte, error) {
 	pj := &priceJSON{
 		Amount:   p.amount.String(),
 		Currency: p.currency,
@@ -87,14 +133,37 @@ func (p Price) MarshalText() (text []byte, err error) {
 	return json.Marshal(pj)
 }
 
+// UnmarshalText reads both formats of MarshalText independent of SetTextFormat, see UnmarshalJSON and DecodeText
 func (p *Price) UnmarshalText(b []byte) error {
-	pj := &priceJSON{}
+	if !bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		decoded, err := DecodeText(string(b))
+		if err != nil {
+			return err
+		}
+		*p = decoded
+		return nil
+	}
+	return p.unmarshalJSONText(b)
+}
+
+// unmarshalJSONText reads the JSON format, the amount may be a string or a JSON number
+func (p *Price) unmarshalJSONText(b []byte) error {
+	pj := &struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency string          `json:"currency"`
+	}{}
 	err := json.Unmarshal(b, pj)
 	if err != nil {
 		return err
 	}
 
-	am, _, err := new(big.Float).Parse(pj.Amount, 10)
+	amount := ""
+	if len(pj.Amount) > 0 {
+		if amount, err = unmarshalJSONAmount(pj.Amount); err != nil {
+			return err
+		}
+	}
+	am, _, err := new(big.Float).Parse(amount, 10)
 	if err != nil {
 		return err
 	}
@@ -105,42 +174,80 @@ func (p *Price) UnmarshalText(b []byte) error {
 	return nil
 }
 
-// MarshalJSON implements interface required by json marshal
+// MarshalJSON implements interface required by json marshal, the amount is a string unless changed with SetJSONAmountMode
 func (p Price) MarshalJSON() (data []byte, err error) {
-	return p.MarshalText()
+	if mode := currentJSONAmountMode(); mode != "" && mode != JSONAmountString {
+		return JSONCodec{AmountMode: mode}.Marshal(p)
+	}
+	return p.marshalJSONText()
 }
 
-// UnmarshalJSON implements encode Unmarshaler
+// UnmarshalJSON implements encode Unmarshaler, the amount may be a string or a JSON number
 func (p *Price) UnmarshalJSON(data []byte) error {
-	return p.UnmarshalText(data)
+	return p.unmarshalJSONText(data)
 }
 
-// MarshalBinary implements interface required by gob
+// MarshalBinary implements interface required by gob.
+// It uses a compact versioned format with the exact binary amount and its precision, so the decoded price is Equal
 func (p Price) MarshalBinary() (data []byte, err error) {
-	return p.MarshalText()
+	return p.marshalCompact(), nil
 }
 
 // UnmarshalBinary implements interface required by gob.
 // Modifies the receiver so it must take a pointer receiver!
+// The JSON format of older versions is still accepted
 func (p *Price) UnmarshalBinary(data []byte) error {
-	return p.UnmarshalText(data)
+	if len(data) > 0 && data[0] == '{' {
+		return p.unmarshalJSONText(data)
+	}
+	return p.unmarshalCompact(data)
 }
 
 // Value makes the Price struct implement the driver.Valuer interface. This method
-// simply returns the JSON-encoded representation of the struct.
+// simply returns the JSON-encoded representation of the struct, the amount is always a string.
 func (p Price) Value() (driver.Value, error) {
-	return json.Marshal(p)
+	return p.marshalJSONText()
 }
 
 // Scan makes the Price struct implement the sql.Scanner interface. This method
-// simply decodes a JSON-encoded value into the struct fields.
+// decodes a JSON-encoded value into the struct fields. Plain decimal strings and numbers,
+// e.g. of NUMERIC columns, only set the amount and keep the currency of the receiver,
+// NULL sets a zero amount
 func (p *Price) Scan(value interface{}) error {
-	b, ok := value.([]byte)
-	if !ok {
-		return errors.New("type assertion to []byte failed")
+	switch v := value.(type) {
+	case nil:
+		*p = NewZero(p.currency)
+		return nil
+	case []byte:
+		return p.scanText(string(v))
+	case string:
+		return p.scanText(v)
+	case int64:
+		*p = NewFromBigFloat(*new(big.Float).SetInt64(v), p.currency)
+		return nil
+	case float64:
+		scanned, err := NewFromFloatChecked(v, p.currency)
+		if err != nil {
+			return err
+		}
+		*p = scanned
+		return nil
 	}
+	return errors.New("unsupported type for price")
+}
 
-	return json.Unmarshal(b, &p)
+// scanText reads a JSON-encoded price or a plain decimal amount
+func (p *Price) scanText(s string) error {
+	trimmed := strings.TrimSpace(s)
+	if strings.HasPrefix(trimmed, "{") {
+		return json.Unmarshal([]byte(trimmed), p)
+	}
+	amount, _, err := new(big.Float).Parse(trimmed, 10)
+	if err != nil || amount.IsInf() {
+		return errors.New("invalid amount " + s)
+	}
+	*p = NewFromBigFloat(*amount, p.currency)
+	return nil
 }
 
 // Add the given price to the current price and returns a new price
@@ -164,13 +271,21 @@ func (p Price) ForceAdd(add Price) Price {
 }
 
 // currencyGuard is a common Guard that protects price calculations of prices with different currency.
-// Robust: if original is Zero and the currencies are different we take the given currency
+// Robust: if original is Zero and the currencies are different we take the given currency, unless SetStrictCurrencyGuard is enabled
 func (p Price) currencyGuard(check Price) (Price, error) {
+	return p.currencyGuardWith(check, IsStrictCurrencyGuard())
+}
+
+// currencyGuardWith is currencyGuard, in strict mode zero prices do not adopt the other currency
+func (p Price) currencyGuardWith(check Price, strict bool) (Price, error) {
 	if p.currency == check.currency {
 		return Price{
 			currency: check.currency,
 		}, nil
 	}
+	if strict {
+		return NewZero(p.currency), ErrCurrencyMismatch
+	}
 	if p.IsZero() {
 		return Price{
 			currency: check.currency,
@@ -182,46 +297,96 @@ func (p Price) currencyGuard(check Price) (Price, error) {
 			currency: p.currency,
 		}, nil
 	}
-	return NewZero(p.currency), errors.New("cannot calculate prices in different currencies")
+	return NewZero(p.currency), ErrCurrencyMismatch
 }
 
-// Discounted returns new price reduced by given percent
+// Discounted returns new price reduced by given percent, NaN and ±Inf are treated as 0% (see NewPercentFromFloat),
+// use DiscountedChecked for untrusted input
 func (p Price) Discounted(percent float64) Price {
-	newPrice := Price{
-		currency: p.currency,
-		amount:   *new(big.Float).Mul(&p.amount, big.NewFloat((100-percent)/100)),
+	return p.DiscountedBy(NewPercentFromFloat(percent))
+}
+
+// DiscountedChecked returns new price reduced by given percent, NaN and ±Inf percentages and infinite prices return an error
+func (p Price) DiscountedChecked(percent float64) (Price, error) {
+	if math.IsNaN(percent) || math.IsInf(percent, 0) {
+		return p, newDetailedError(ErrNotFinite, "percent must be a finite number")
 	}
-	return newPrice
+	if err := p.checkFinite(); err != nil {
+		return p, err
+	}
+	return p.Discounted(percent), nil
+}
+
+// DiscountedBy returns new price reduced by given percent
+func (p Price) DiscountedBy(percent Percent) Price {
+	return p.mulRat(new(big.Rat).Quo(new(big.Rat).Sub(big.NewRat(100, 1), &percent.rat), big.NewRat(100, 1)))
 }
 
-// Taxed returns new price added with Tax (assuming current price is net)
+// Taxed returns new price added with Tax (assuming current price is net), ±Inf is treated as 0%,
+// use TaxedChecked for untrusted input
 func (p Price) Taxed(percent big.Float) Price {
-	newPrice := Price{
-		currency: p.currency,
-		amount:   *new(big.Float).Add(&p.amount, p.TaxFromNet(percent).Amount()),
+	return p.TaxedBy(NewPercentFromBigFloat(percent))
+}
+
+// TaxedChecked returns new price added with Tax (assuming current price is net), ±Inf percentages and infinite prices return an error
+func (p Price) TaxedChecked(percent big.Float) (Price, error) {
+	if percent.IsInf() {
+		return p, newDetailedError(ErrNotFinite, "percent must be a finite number")
 	}
-	return newPrice
+	if err := p.checkFinite(); err != nil {
+		return p, err
+	}
+	return p.Taxed(percent), nil
+}
+
+// TaxedBy returns new price added with Tax (assuming current price is net)
+func (p Price) TaxedBy(percent Percent) Price {
+	return p.mulRat(new(big.Rat).Quo(new(big.Rat).Add(big.NewRat(100, 1), &percent.rat), big.NewRat(100, 1)))
 }
 
 // TaxFromNet returns new price representing the tax amount (assuming the current price is net 100%)
 func (p Price) TaxFromNet(percent big.Float) Price {
-	quo := new(big.Float).Mul(&percent, &p.amount)
-	newPrice := Price{
-		currency: p.currency,
-		amount:   *new(big.Float).Quo(quo, new(big.Float).SetInt64(100)),
-	}
-	return newPrice
+	return p.TaxFromNetBy(NewPercentFromBigFloat(percent))
+}
+
+// TaxFromNetBy returns new price representing the tax amount (assuming the current price is net 100%)
+func (p Price) TaxFromNetBy(percent Percent) Price {
+	return percent.Of(p)
 }
 
 // TaxFromGross returns new price representing the tax amount (assuming the current price is gross 100+percent)
 func (p Price) TaxFromGross(percent big.Float) Price {
-	quo := new(big.Float).Mul(&percent, &p.amount)
-	percent100 := new(big.Float).Add(&percent, new(big.Float).SetInt64(100))
-	newPrice := Price{
+	return p.TaxFromGrossBy(NewPercentFromBigFloat(percent))
+}
+
+// TaxFromGrossBy returns new price representing the tax amount (assuming the current price is gross 100+percent)
+func (p Price) TaxFromGrossBy(percent Percent) Price {
+	percent100 := new(big.Rat).Add(&percent.rat, big.NewRat(100, 1))
+	if percent100.Sign() == 0 {
+		return NewZero(p.currency)
+	}
+	return p.mulRat(new(big.Rat).Quo(&percent.rat, percent100))
+}
+
+// mulRat returns a new price with the amount multiplied exactly by the given factor
+func (p Price) mulRat(factor *big.Rat) Price {
+	if p.amount.IsInf() {
+		return p.Clone()
+	}
+	amount, _ := p.amount.Rat(nil)
+	return p.withRat(amount.Mul(amount, factor))
+}
+
+// withRat returns a new price in the same currency with the given amount, the precision is kept at least at 64 bits
+func (p Price) withRat(amount *big.Rat) Price {
+	prec := p.amount.Prec()
+	if prec < 64 {
+		prec = 64
+	}
+	return Price{
+		amount:   *new(big.Float).SetPrec(prec).SetRat(amount),
 		currency: p.currency,
-		amount:   *new(big.Float).Quo(quo, percent100),
 	}
-	return newPrice
 }
 
 // Sub the given price from the current price and returns a new price
@@ -241,15 +406,76 @@ func (p Price) Inverse() Price {
 	return p
 }
 
+// Abs returns the price with the absolute amount
+func (p Price) Abs() Price {
+	p.amount = *new(big.Float).Abs(&p.amount)
+	return p
+}
+
 // Multiply returns a new price with the amount Multiply
 func (p Price) Multiply(qty int) Price {
+	return p.MultiplyInt64(int64(qty))
+}
+
+// MultiplyChecked returns a new price with the amount multiplied by qty, infinite prices return an error
+func (p Price) MultiplyChecked(qty int) (Price, error) {
+	if err := p.checkFinite(); err != nil {
+		return p, err
+	}
+	return p.Multiply(qty), nil
+}
+
+// checkFinite returns an error for infinite amounts
+func (p Price) checkFinite() error {
+	if p.amount.IsInf() {
+		return ErrNotFinite
+	}
+	return nil
+}
+
+// MultiplyInt64 returns a new price with the amount multiplied by qty.
+// The result is exact, the precision of the amount grows as needed
+func (p Price) MultiplyInt64(qty int64) Price {
+	return p.MultiplyBigInt(big.NewInt(qty))
+}
+
+// MultiplyBigInt returns a new price with the amount multiplied by qty, e.g. for unit counts beyond int64.
+// The result is exact, the precision of the amount grows as needed
+func (p Price) MultiplyBigInt(qty *big.Int) Price {
+	if p.amount.IsInf() && qty.Sign() == 0 {
+		// Inf * 0 is not defined and would panic
+		return NewZero(p.currency)
+	}
+	qtyF := new(big.Float).SetInt(qty)
+	prec := p.amount.Prec() + qtyF.Prec()
+	if prec < 64 {
+		prec = 64
+	}
 	newPrice := Price{
 		currency: p.currency,
 	}
-	newPrice.amount.Mul(&p.amount, new(big.Float).SetInt64(int64(qty)))
+	newPrice.amount.SetPrec(prec).Mul(&p.amount, qtyF)
 	return newPrice
 }
 
+// MultiplyFloat returns a new price with the amount multiplied by f, e.g. a price per kg multiplied with the weight.
+// f is used with its shortest decimal representation, so 0.1 is exactly a tenth. NaN and Inf result in a zero price
+func (p Price) MultiplyFloat(f float64) Price {
+	factor, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	if !ok {
+		return NewZero(p.currency)
+	}
+	return p.mulRat(factor)
+}
+
+// MultiplyRat returns a new price with the amount multiplied by the exact factor r, a nil factor results in a zero price
+func (p Price) MultiplyRat(r *big.Rat) Price {
+	if r == nil {
+		return NewZero(p.currency)
+	}
+	return p.mulRat(r)
+}
+
 // Divided returns a new price with the amount Divided
 func (p Price) Divided(qty int) Price {
 	newPrice := Price{
@@ -262,6 +488,106 @@ func (p Price) Divided(qty int) Price {
 	return newPrice
 }
 
+// DividedWithRemainder divides the payable price by qty and returns the payable quotient (rounded like GetPayable)
+// together with the remainder, so that qty * quotient + remainder equals the payable price and no cent is lost.
+// The remainder is negative if the quotient was rounded up. With qty 0 the whole payable price is the remainder.
+// Infinite prices have no payable units, the quotient is infinite like with Divided and the remainder is zero.
+func (p Price) DividedWithRemainder(qty int) (quotient Price, remainder Price) {
+	if p.amount.IsInf() {
+		if qty == 0 {
+			return NewZero(p.currency), p
+		}
+		return p.Divided(qty), NewZero(p.currency)
+	}
+	units, precision := p.payableUnits()
+	if qty == 0 {
+		return NewZero(p.currency), p.withRat(new(big.Rat).SetFrac(units, big.NewInt(int64(precision))))
+	}
+
+	mode, _ := p.payableRoundingPrecision()
+	quotientUnits := roundRat(new(big.Rat).SetFrac(units, big.NewInt(int64(qty))), mode)
+	remainderUnits := new(big.Int).Sub(units, new(big.Int).Mul(quotientUnits, big.NewInt(int64(qty))))
+
+	return p.withRat(new(big.Rat).SetFrac(quotientUnits, big.NewInt(int64(precision)))),
+		p.withRat(new(big.Rat).SetFrac(remainderUnits, big.NewInt(int64(precision))))
+}
+
+// Mod returns the remainder of dividing the price by divisor, the remainder has the sign of the price (like the % operator).
+// E.g. 57.30 mod 20.00 is 17.30, useful to denominate an amount into bills and coins.
+func (p Price) Mod(divisor Price) (Price, error) {
+	if p.currency != divisor.currency {
+		return NewZero(p.currency), ErrCurrencyMismatch
+	}
+	if divisor.IsZero() {
+		return NewZero(p.currency), ErrDivisionByZero
+	}
+	if p.amount.IsInf() || divisor.amount.IsInf() {
+		return NewZero(p.currency), newDetailedError(ErrNotFinite, "cannot divide infinite amounts")
+	}
+
+	amount, _ := p.amount.Rat(nil)
+	div, _ := divisor.amount.Rat(nil)
+	quo := new(big.Rat).Quo(amount, div)
+	times := new(big.Int).Quo(quo.Num(), quo.Denom())
+	remainder := new(big.Rat).Sub(amount, div.Mul(div, new(big.Rat).SetInt(times)))
+	return p.withRat(remainder), nil
+}
+
+// DivMod divides the price by qty with the quotient truncated to the payable precision and returns the quotient
+// together with the remainder, so that qty * quotient + remainder equals the price.
+// E.g. 100.00 paid out in 3 batches are 33.33 per batch with a remainder of 0.01
+func (p Price) DivMod(qty int) (quotient Price, remainder Price, err error) {
+	if qty <= 0 {
+		return NewZero(p.currency), NewZero(p.currency), newDetailedError(ErrInvalidSplitCount, "qty must be higher than zero")
+	}
+	if p.amount.IsInf() {
+		return NewZero(p.currency), NewZero(p.currency), newDetailedError(ErrNotFinite, "cannot divide infinite amounts")
+	}
+
+	_, precision := p.payableRoundingPrecision()
+	amount, _ := p.amount.Rat(nil)
+	scaled := new(big.Rat).Mul(amount, big.NewRat(int64(precision), int64(qty)))
+	units := new(big.Int).Quo(scaled.Num(), scaled.Denom())
+
+	quotientAmount := new(big.Rat).SetFrac(units, big.NewInt(int64(precision)))
+	remainderAmount := new(big.Rat).Sub(amount, new(big.Rat).Mul(quotientAmount, big.NewRat(int64(qty), 1)))
+	return p.withRat(quotientAmount), p.withRat(remainderAmount), nil
+}
+
+// PercentageOf returns the share of the price in base in percent, e.g. a discount of 12.5 compared to a list price of 100 is 12.5
+func (p Price) PercentageOf(base Price) (big.Float, error) {
+	ratio, err := p.ratio(base)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *new(big.Float).SetPrec(64).SetRat(ratio.Mul(ratio, big.NewRat(100, 1))), nil
+}
+
+// RatioTo returns the price divided by base, e.g. the progress towards a threshold with 1 meaning reached
+func (p Price) RatioTo(base Price) (big.Float, error) {
+	ratio, err := p.ratio(base)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *new(big.Float).SetPrec(64).SetRat(ratio), nil
+}
+
+// ratio returns the exact quotient of p and base
+func (p Price) ratio(base Price) (*big.Rat, error) {
+	if p.currency != base.currency {
+		return nil, ErrCurrencyMismatch
+	}
+	if base.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	if p.amount.IsInf() || base.amount.IsInf() {
+		return nil, newDetailedError(ErrNotFinite, "cannot divide infinite amounts")
+	}
+	amount, _ := p.amount.Rat(nil)
+	baseAmount, _ := base.amount.Rat(nil)
+	return amount.Quo(amount, baseAmount), nil
+}
+
 // Equal compares the prices exact
 func (p Price) Equal(cmp Price) bool {
 	if p.currency != cmp.currency {
@@ -280,6 +606,15 @@ func (p Price) LikelyEqual(cmp Price) bool {
 	return absDiff.Cmp(big.NewFloat(0.000000001)) == -1
 }
 
+// Cmp compares the price with cmp and returns -1, 0 or 1 if it is lower, equal or higher.
+// Unlike IsLessThen and IsGreaterThen a currency mismatch is returned as error
+func (p Price) Cmp(cmp Price) (int, error) {
+	if p.currency != cmp.currency {
+		return 0, newDetailedError(ErrCurrencyMismatch, "cannot compare prices in different currencies")
+	}
+	return p.amount.Cmp(&cmp.amount), nil
+}
+
 // IsLessThen compares the current price with a given one
 func (p Price) IsLessThen(cmp Price) bool {
 	if p.currency != cmp.currency {
@@ -296,6 +631,47 @@ func (p Price) IsGreaterThen(cmp Price) bool {
 	return p.amount.Cmp(&cmp.amount) == 1
 }
 
+// IsLessThan compares the current price with a given one, it is the correctly spelled IsLessThen
+func (p Price) IsLessThan(cmp Price) bool {
+	return p.IsLessThen(cmp)
+}
+
+// IsGreaterThan compares the current price with a given one, it is the correctly spelled IsGreaterThen
+func (p Price) IsGreaterThan(cmp Price) bool {
+	return p.IsGreaterThen(cmp)
+}
+
+// IsLessThanOrEqual returns true if the price is lower than or equal to the given one, false for different currencies
+func (p Price) IsLessThanOrEqual(cmp Price) bool {
+	if p.currency != cmp.currency {
+		return false
+	}
+	return p.amount.Cmp(&cmp.amount) <= 0
+}
+
+// IsGreaterThanOrEqual returns true if the price is higher than or equal to the given one, false for different currencies.
+// E.g. free shipping at 50 EUR or more
+func (p Price) IsGreaterThanOrEqual(cmp Price) bool {
+	if p.currency != cmp.currency {
+		return false
+	}
+	return p.amount.Cmp(&cmp.amount) >= 0
+}
+
+// Between returns true if the price lies between lo and hi, with inclusive the bounds itself match as well
+func (p Price) Between(lo, hi Price, inclusive bool) (bool, error) {
+	if p.currency != lo.currency || p.currency != hi.currency {
+		return false, newDetailedError(ErrCurrencyMismatch, "cannot compare prices in different currencies")
+	}
+	if lo.amount.Cmp(&hi.amount) > 0 {
+		return false, ErrInvalidBounds
+	}
+	if inclusive {
+		return p.amount.Cmp(&lo.amount) >= 0 && p.amount.Cmp(&hi.amount) <= 0, nil
+	}
+	return p.amount.Cmp(&lo.amount) > 0 && p.amount.Cmp(&hi.amount) < 0, nil
+}
+
 // IsLessThenValue compares the price with a given amount value (assuming same currency)
 func (p Price) IsLessThenValue(amount big.Float) bool {
 	return p.amount.Cmp(&amount) == -1
@@ -339,56 +715,63 @@ func (p Price) GetPayable() Price {
 	return p.GetPayableByRoundingMode(mode, precision)
 }
 
+// GetPayableWith rounds the price like GetPayable but with the rounding configuration of the given provider,
+// e.g. to apply tenant specific rounding
+func (p Price) GetPayableWith(provider RoundingConfigProvider) Price {
+	config := roundingConfigFrom(provider, p.currency)
+	return p.GetPayableByRoundingMode(config.Mode, config.Precision)
+}
+
 // GetPayableByRoundingMode returns the price rounded you can pass the used rounding mode and precision
 // Example for precision 100:
 //
 //	1.115 >  1.12 (RoundingModeHalfUp)  / 1.11 (RoundingModeFloor)
 //	-1.115 > -1.11 (RoundingModeHalfUp) / -1.12 (RoundingModeFloor)
-func (p Price) GetPayableByRoundingMode(mode string, precision int) Price {
+//
+// An unknown mode truncates the amount, use RoundingMode.Validate or ParseRoundingMode to check modes from configuration
+func (p Price) GetPayableByRoundingMode(mode RoundingMode, precision int) Price {
 	newPrice := Price{
 		currency: p.currency,
 	}
-
-	amountForRound := new(big.Float).Copy(&p.amount)
-	negative := int64(1)
-	if p.IsNegative() {
-		negative = -1
-	}
-
-	amountTruncatedFloat, _ := new(big.Float).Mul(amountForRound, p.precisionF(precision)).Float64()
-	integerPart, fractionalPart := math.Modf(amountTruncatedFloat)
-	amountTruncatedInt := int64(integerPart)
-	valueAfterPrecision := (math.Round(fractionalPart*1000) / 100) * float64(negative)
-	if amountTruncatedFloat >= float64(math.MaxInt64) {
-		// will not work if we are already above MaxInt - so we return unrounded price:
+	if p.amount.IsInf() {
+		// infinite amounts cannot be rounded
 		newPrice.amount = p.amount
 		return newPrice
 	}
 
+	newPrice.amount = *roundScaled(new(big.Float).Mul(&p.amount, p.precisionF(precision)), mode, precision)
+	return newPrice
+}
+
+// roundScaled rounds the amount already multiplied by precision with big.Int arithmetic and divides it by precision again.
+// The fraction is evaluated with three decimal digits, so binary representation errors like 0.4999999 count as 0.5
+func roundScaled(scaled *big.Float, mode RoundingMode, precision int) *big.Float {
+	integer, _ := scaled.Int(nil)
+	fraction := new(big.Float).SetPrec(scaled.Prec()).Sub(scaled, new(big.Float).SetInt(integer))
+	fractionRat, _ := fraction.Abs(fraction).Rat(nil)
+	thousandths := roundRat(fractionRat.Mul(fractionRat, big.NewRat(1000, 1)), RoundingModeHalfUp).Int64()
+
+	sign := int64(scaled.Sign())
+	roundAway := false
 	switch mode {
 	case RoundingModeCeil:
-		if negative == 1 && valueAfterPrecision > 0 {
-			amountTruncatedInt = amountTruncatedInt + negative
-		}
+		roundAway = sign > 0 && thousandths > 0
 	case RoundingModeHalfUp:
-		if valueAfterPrecision >= 5 {
-			amountTruncatedInt = amountTruncatedInt + negative
-		}
+		roundAway = thousandths >= 500
 	case RoundingModeHalfDown:
-		if valueAfterPrecision > 5 {
-			amountTruncatedInt = amountTruncatedInt + negative
-		}
+		roundAway = thousandths > 500
+	case RoundingModeHalfEven:
+		roundAway = thousandths > 500 || (thousandths == 500 && integer.Bit(0) == 1)
 	case RoundingModeFloor:
-		if negative == -1 && valueAfterPrecision > 0 {
-			amountTruncatedInt = amountTruncatedInt + negative
-		}
+		roundAway = sign < 0 && thousandths > 0
 	default:
 		// nothing to round
 	}
+	if roundAway {
+		integer.Add(integer, big.NewInt(sign))
+	}
 
-	amountRounded := new(big.Float).Quo(new(big.Float).SetInt64(amountTruncatedInt), p.precisionF(precision))
-	newPrice.amount = *amountRounded
-	return newPrice
+	return new(big.Float).Quo(new(big.Float).SetInt(integer), new(big.Float).SetInt64(int64(precision)))
 }
 
 // precisionF returns big.Float from int
@@ -396,67 +779,85 @@ func (p Price) precisionF(precision int) *big.Float {
 	return new(big.Float).SetInt64(int64(precision))
 }
 
-// precisionF - 10 * n - n is the amount of decimal numbers after comma
-// - can be currency specific (for now defaults to 2)
-// - TODO - use currency configuration or registry
-func (p Price) payableRoundingPrecision() (string, int) {
-	if strings.ToLower(p.currency) == "miles" || strings.ToLower(p.currency) == "points" {
-		return RoundingModeFloor, int(1)
-	}
-	return RoundingModeHalfUp, int(100)
+// payableRoundingPrecision - 10 * n - n is the amount of decimal numbers after comma
+// - currency specific, see SetRoundingConfigProvider (defaults to 2)
+func (p Price) payableRoundingPrecision() (RoundingMode, int) {
+	config := roundingConfigFrom(currentRoundingConfigProvider(), p.currency)
+	return config.Mode, config.Precision
 }
 
 // SplitInPayables returns "count" payable prices (each rounded) that in sum matches the given price
 //   - Given a price of 12.456 (Payable 12,46)  - Splitted in 6 will mean: 6 * 2.076
-//   - but having them payable requires rounding them each (e.g. 2.07) which would mean we have 0.03 difference (=12,45-6*2.07)
+//   - but having them payable requires rounding them each (e.g. 2.07) which would mean we have 0.04 difference (=12,46-6*2.07)
 //   - so that the sum is as close as possible to the original value   in this case the correct return will be:
-//   - 2.07 + 2.07+2.08 +2.08 +2.08 +2.08
-func (p Price) SplitInPayables(count int) ([]Price, error) {
+//   - 2.08 + 2.08 + 2.08 + 2.08 + 2.07 + 2.07
+//
+// By default the remaining cents go to the first parts, use WithRemainder to choose another RemainderStrategy
+func (p Price) SplitInPayables(count int, opts ...SplitOption) ([]Price, error) {
 	if count <= 0 {
-		return nil, errors.New("split must be higher than zero")
+		return nil, ErrInvalidSplitCount
 	}
-	// guard clause invert negative values
-	_, precision := p.payableRoundingPrecision()
-	amount := p.GetPayable().Amount()
-	// we have to invert negative numbers, otherwise split is not correct
-	if p.IsNegative() {
-		amount = p.GetPayable().Inverse().Amount()
+	weights := make([]*big.Rat, count)
+	for i := range weights {
+		weights[i] = big.NewRat(1, 1)
 	}
-	amountToMatchFloat, _ := new(big.Float).Mul(amount, p.precisionF(precision)).Float64()
-	amountToMatchInt := int64(amountToMatchFloat)
-
-	splittedAmountModulo := amountToMatchInt % int64(count)
-	splittedAmount := amountToMatchInt / int64(count)
+	return p.allocate(weights, opts...)
+}
 
-	splittedAmounts := make([]int64, count)
-	for i := 0; i < count; i++ {
-		splittedAmounts[i] = splittedAmount
+// Clone returns a copy of the price - the amount gets Excat acc
+func (p Price) Clone() Price {
+	return Price{
+		amount:   *new(big.Float).Set(&p.amount),
+		currency: p.currency,
 	}
+}
 
-	for i := 0; i < int(splittedAmountModulo); i++ {
-		splittedAmounts[i] = splittedAmounts[i] + 1
+// canonicalAmount returns the shortest decimal string without exponent that rounds to the amount at its precision,
+// negative zero is normalized. Equal amounts of different precisions may differ, see exactAmount
+func (p Price) canonicalAmount() string {
+	if p.amount.Sign() == 0 {
+		return "0"
 	}
+	return p.amount.Text('f', -1)
+}
 
-	prices := make([]Price, count)
-	for i := 0; i < count; i++ {
-		_, precision := p.payableRoundingPrecision()
-		splittedAmount := splittedAmounts[i]
-		// invert prices again to keep negative values
-		if p.IsNegative() {
-			splittedAmount *= -1
-		}
-		prices[i] = NewFromInt(splittedAmount, precision, p.Currency())
+// exactAmount returns the exact binary value of the amount as decimal string without exponent,
+// e.g. "0.1000000000000000055511151231257827021181583404541015625" for NewFromFloat(0.1, "EUR").
+// Unlike canonicalAmount it does not depend on the precision, so equal amounts have equal strings
+func (p Price) exactAmount() string {
+	if p.amount.IsInf() {
+		return p.amount.Text('f', -1)
 	}
+	r, _ := p.amount.Rat(nil)
+	s, _ := ratDecimalString(r, 0)
+	return s
+}
 
-	return prices, nil
+// decimalParts returns the canonical amount as coefficient * 10^exp, e.g. 1234 and -2 for 12.34.
+// The amount must be finite
+func (p Price) decimalParts() (*big.Int, int) {
+	amount := p.canonicalAmount()
+	negative := strings.HasPrefix(amount, "-")
+	integer, fraction, _ := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+	coefficient, _ := new(big.Int).SetString(integer+fraction, 10)
+	if negative {
+		coefficient.Neg(coefficient)
+	}
+	return coefficient, -len(fraction)
 }
 
-// Clone returns a copy of the price - the amount gets Excat acc
-func (p Price) Clone() Price {
-	return Price{
-		amount:   *new(big.Float).Set(&p.amount),
-		currency: p.currency,
+// newFromDecimalParts returns the price coefficient * 10^exp. Like UnmarshalText amounts get 64 bits,
+// longer coefficients get 128 bits
+func newFromDecimalParts(coefficient *big.Int, exp int, currency string) (Price, error) {
+	prec := uint(64)
+	if coefficient.BitLen() > 64 {
+		prec = 128
 	}
+	amount, _, err := new(big.Float).SetPrec(prec).Parse(coefficient.String()+"e"+strconv.Itoa(exp), 10)
+	if err != nil {
+		return NewZero(currency), err
+	}
+	return Price{amount: *amount, currency: currency}, nil
 }
 
 // Currency returns currency
@@ -464,23 +865,63 @@ func (p Price) Currency() string {
 	return p.currency
 }
 
-// Amount returns exact amount as bigFloat
+// CurrencyCode returns the normalized currency, e.g. "EUR" for a price in "eur"
+func (p Price) CurrencyCode() Currency {
+	return NewCurrency(p.currency)
+}
+
+// Amount returns a copy of the exact amount as bigFloat, changing it does not modify the price
 func (p Price) Amount() *big.Float {
-	return &p.amount
+	return new(big.Float).Set(&p.amount)
 }
 
 // SumAll returns new price with sum of all given prices
 func SumAll(prices ...Price) (Price, error) {
 	if len(prices) == 0 {
-		return NewZero(""), errors.New("no price given")
+		return NewZero(""), ErrEmptyPriceList
 	}
-	result := prices[0].Clone()
-	var err error
-	for _, price := range prices[1:] {
-		result, err = result.Add(price)
-		if err != nil {
-			return result, err
+	sum := NewAccumulator(prices[0].currency)
+	for _, price := range prices {
+		if err := sum.Add(price); err != nil {
+			return sum.Result(), err
 		}
 	}
-	return result, nil
+	return sum.Result(), nil
+}
+
+// Min returns the lower of both prices
+func Min(a, b Price) (Price, error) {
+	if a.currency != b.currency {
+		return NewZero(a.currency), ErrCurrencyMismatch
+	}
+	if b.amount.Cmp(&a.amount) < 0 {
+		return b.Clone(), nil
+	}
+	return a.Clone(), nil
+}
+
+// Max returns the higher of both prices
+func Max(a, b Price) (Price, error) {
+	if a.currency != b.currency {
+		return NewZero(a.currency), ErrCurrencyMismatch
+	}
+	if b.amount.Cmp(&a.amount) > 0 {
+		return b.Clone(), nil
+	}
+	return a.Clone(), nil
+}
+
+// Clamp returns the price limited to the range lo to hi (both inclusive), e.g. to never go below a floor price
+func (p Price) Clamp(lo, hi Price) (Price, error) {
+	if lo.currency != hi.currency {
+		return NewZero(p.currency), ErrCurrencyMismatch
+	}
+	if lo.amount.Cmp(&hi.amount) > 0 {
+		return NewZero(p.currency), ErrInvalidBounds
+	}
+	result, err := Max(p, lo)
+	if err != nil {
+		return result, err
+	}
+	return Min(result, hi)
 }
@@ -0,0 +1,161 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+)
+
+type (
+	// PriceBookEntry holds the tiered unit prices of a SKU for a customer group in one currency.
+	// An empty CustomerGroup is the default used for all groups without own entry
+	PriceBookEntry struct {
+		SKU           string     `json:"sku"`
+		CustomerGroup string     `json:"customerGroup,omitempty"`
+		Tiers         PriceTiers `json:"tiers"`
+	}
+
+	// PriceBookQuery describes the price to resolve, a Quantity of 0 is treated as 1
+	PriceBookQuery struct {
+		SKU           string
+		CustomerGroup string
+		Currency      string
+		Quantity      int
+	}
+
+	// PriceBookSource provides the entries of a price book, implement it to back a PriceBook by a database or service
+	PriceBookSource interface {
+		// Entries returns all entries of the SKU, unknown SKUs result in no entries
+		Entries(sku string) ([]PriceBookEntry, error)
+	}
+
+	// PriceBook resolves unit prices from a PriceBookSource
+	PriceBook struct {
+		source PriceBookSource
+	}
+
+	// MemoryPriceBookSource is a PriceBookSource holding its entries in memory
+	MemoryPriceBookSource struct {
+		mu      sync.RWMutex
+		entries map[string][]PriceBookEntry
+	}
+)
+
+// NewPriceBook returns a price book backed by source
+func NewPriceBook(source PriceBookSource) *PriceBook {
+	return &PriceBook{source: source}
+}
+
+// Resolve returns the unit price for the query. An entry of the customer group is preferred over the default entry,
+// within the entry the quantity selects the tier
+func (b *PriceBook) Resolve(query PriceBookQuery) (Price, error) {
+	entry, err := b.entry(query)
+	if err != nil {
+		return Price{}, err
+	}
+	return entry.Tiers.Resolve(query.quantity())
+}
+
+// Total returns the price for the queried quantity calculated with the given tier mode
+func (b *PriceBook) Total(query PriceBookQuery, mode TierMode) (Price, error) {
+	entry, err := b.entry(query)
+	if err != nil {
+		return Price{}, err
+	}
+	return entry.Tiers.Total(query.quantity(), mode)
+}
+
+func (b *PriceBook) entry(query PriceBookQuery) (PriceBookEntry, error) {
+	if b.source == nil {
+		return PriceBookEntry{}, errors.New("price book has no source")
+	}
+	entries, err := b.source.Entries(query.SKU)
+	if err != nil {
+		return PriceBookEntry{}, err
+	}
+
+	var fallback *PriceBookEntry
+	for i, entry := range entries {
+		if entry.SKU != query.SKU || entry.currency() != query.Currency {
+			continue
+		}
+		if entry.CustomerGroup == query.CustomerGroup {
+			return entry, nil
+		}
+		if entry.CustomerGroup == "" {
+			fallback = &entries[i]
+		}
+	}
+	if fallback == nil {
+		return PriceBookEntry{}, errors.New("no price found for sku " + query.SKU + " in " + query.Currency)
+	}
+	return *fallback, nil
+}
+
+func (q PriceBookQuery) quantity() int {
+	if q.Quantity == 0 {
+		return 1
+	}
+	return q.Quantity
+}
+
+func (e PriceBookEntry) currency() string {
+	if len(e.Tiers) == 0 {
+		return ""
+	}
+	return e.Tiers[0].UnitPrice.Currency()
+}
+
+// NewMemoryPriceBookSource returns an empty in memory source
+func NewMemoryPriceBookSource() *MemoryPriceBookSource {
+	return &MemoryPriceBookSource{entries: map[string][]PriceBookEntry{}}
+}
+
+// LoadPriceBookJSON reads a JSON array of entries into a new in memory source
+func LoadPriceBookJSON(r io.Reader) (*MemoryPriceBookSource, error) {
+	var entries []PriceBookEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	source := NewMemoryPriceBookSource()
+	for _, entry := range entries {
+		if err := source.Add(entry); err != nil {
+			return nil, err
+		}
+	}
+	return source, nil
+}
+
+// Add validates the entry and adds it, an existing entry for the same SKU, customer group and currency is replaced
+func (s *MemoryPriceBookSource) Add(entry PriceBookEntry) error {
+	if entry.SKU == "" {
+		return errors.New("price book entry needs a sku")
+	}
+	tiers, err := NewPriceTiers(entry.Tiers...)
+	if err != nil {
+		return err
+	}
+	entry.Tiers = tiers
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing := s.entries[entry.SKU]
+	for i, e := range existing {
+		if e.CustomerGroup == entry.CustomerGroup && e.currency() == entry.currency() {
+			existing[i] = entry
+			return nil
+		}
+	}
+	s.entries[entry.SKU] = append(existing, entry)
+	return nil
+}
+
+// Entries returns a copy of the entries of the SKU
+func (s *MemoryPriceBookSource) Entries(sku string) ([]PriceBookEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]PriceBookEntry, len(s.entries[sku]))
+	copy(entries, s.entries[sku])
+	return entries, nil
+}
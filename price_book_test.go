@@ -0,0 +1,92 @@
+package price
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPriceBookJSON = `[
+	{"sku": "A-1", "tiers": [
+		{"minQuantity": 1, "unitPrice": {"amount": "10", "currency": "EUR"}},
+		{"minQuantity": 10, "unitPrice": {"amount": "8", "currency": "EUR"}}
+	]},
+	{"sku": "A-1", "customerGroup": "b2b", "tiers": [
+		{"minQuantity": 1, "unitPrice": {"amount": "9", "currency": "EUR"}}
+	]},
+	{"sku": "A-1", "tiers": [
+		{"minQuantity": 1, "unitPrice": {"amount": "12", "currency": "USD"}}
+	]}
+]`
+
+type failingPriceBookSource struct{}
+
+func (failingPriceBookSource) Entries(string) ([]PriceBookEntry, error) {
+	return nil, errors.New("source not reachable")
+}
+
+func TestPriceBook_Resolve(t *testing.T) {
+	source, err := LoadPriceBookJSON(strings.NewReader(testPriceBookJSON))
+	require.NoError(t, err)
+	book := NewPriceBook(source)
+
+	tests := []struct {
+		name  string
+		query PriceBookQuery
+		want  Price
+	}{
+		{name: "default", query: PriceBookQuery{SKU: "A-1", Currency: "EUR"}, want: NewFromInt(10, 1, "EUR")},
+		{name: "quantity tier", query: PriceBookQuery{SKU: "A-1", Currency: "EUR", Quantity: 12}, want: NewFromInt(8, 1, "EUR")},
+		{name: "customer group", query: PriceBookQuery{SKU: "A-1", CustomerGroup: "b2b", Currency: "EUR", Quantity: 12}, want: NewFromInt(9, 1, "EUR")},
+		{name: "unknown group falls back", query: PriceBookQuery{SKU: "A-1", CustomerGroup: "vip", Currency: "EUR"}, want: NewFromInt(10, 1, "EUR")},
+		{name: "currency", query: PriceBookQuery{SKU: "A-1", CustomerGroup: "b2b", Currency: "USD"}, want: NewFromInt(12, 1, "USD")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := book.Resolve(tt.query)
+			require.NoError(t, err)
+			assert.True(t, result.Equal(tt.want), result.Display())
+		})
+	}
+
+	_, err = book.Resolve(PriceBookQuery{SKU: "B-2", Currency: "EUR"})
+	assert.Error(t, err)
+
+	_, err = book.Resolve(PriceBookQuery{SKU: "A-1", Currency: "CHF"})
+	assert.Error(t, err)
+
+	_, err = NewPriceBook(failingPriceBookSource{}).Resolve(PriceBookQuery{SKU: "A-1", Currency: "EUR"})
+	assert.Error(t, err)
+}
+
+func TestPriceBook_Total(t *testing.T) {
+	source, err := LoadPriceBookJSON(strings.NewReader(testPriceBookJSON))
+	require.NoError(t, err)
+
+	total, err := NewPriceBook(source).Total(PriceBookQuery{SKU: "A-1", Currency: "EUR", Quantity: 12}, TierModeGraduated)
+	require.NoError(t, err)
+	assert.Equal(t, "114.00", total.Amount().Text('f', 2))
+}
+
+func TestMemoryPriceBookSource_Add(t *testing.T) {
+	source := NewMemoryPriceBookSource()
+	require.NoError(t, source.Add(PriceBookEntry{SKU: "A-1", Tiers: PriceTiers{{MinQuantity: 1, UnitPrice: NewFromInt(1, 1, "EUR")}}}))
+	require.NoError(t, source.Add(PriceBookEntry{SKU: "A-1", Tiers: PriceTiers{{MinQuantity: 1, UnitPrice: NewFromInt(2, 1, "EUR")}}}))
+
+	entries, err := source.Entries("A-1")
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "same sku, group and currency is replaced")
+	assert.True(t, entries[0].Tiers[0].UnitPrice.Equal(NewFromInt(2, 1, "EUR")))
+
+	assert.Error(t, source.Add(PriceBookEntry{Tiers: PriceTiers{{MinQuantity: 1, UnitPrice: NewFromInt(1, 1, "EUR")}}}))
+	assert.Error(t, source.Add(PriceBookEntry{SKU: "A-2"}))
+
+	_, err = LoadPriceBookJSON(strings.NewReader(`[{"sku": "A-1", "tiers": []}]`))
+	assert.Error(t, err)
+
+	_, err = LoadPriceBookJSON(strings.NewReader(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// PriceRange is a range of prices in one currency with inclusive bounds, e.g. for listing filters or "from 9.99 EUR" displays
+type PriceRange struct {
+	Min Price `json:"min"`
+	Max Price `json:"max"`
+}
+
+// NewPriceRange returns a validated price range
+func NewPriceRange(min, max Price) (PriceRange, error) {
+	r := PriceRange{Min: min, Max: max}
+	if err := r.validate(); err != nil {
+		return PriceRange{}, err
+	}
+	return r, nil
+}
+
+// IsValid returns true if both bounds have the same currency and Min is not higher than Max
+func (r PriceRange) IsValid() bool {
+	return r.validate() == nil
+}
+
+// Currency returns the currency of the range
+func (r PriceRange) Currency() string {
+	return r.Min.Currency()
+}
+
+// Contains returns true if the price lies within the range including the bounds
+func (r PriceRange) Contains(p Price) bool {
+	result, err := p.Between(r.Min, r.Max, true)
+	return err == nil && result
+}
+
+// Intersect returns the range covered by both ranges, an error is returned for ranges that do not overlap
+func (r PriceRange) Intersect(other PriceRange) (PriceRange, error) {
+	if err := r.guard(other); err != nil {
+		return PriceRange{}, err
+	}
+	lo, _ := Max(r.Min, other.Min)
+	hi, _ := Min(r.Max, other.Max)
+	if lo.IsGreaterThen(hi) {
+		return PriceRange{}, errors.New("price ranges do not overlap")
+	}
+	return PriceRange{Min: lo, Max: hi}, nil
+}
+
+// Union returns the smallest range covering both ranges, a gap between both ranges is covered as well
+func (r PriceRange) Union(other PriceRange) (PriceRange, error) {
+	if err := r.guard(other); err != nil {
+		return PriceRange{}, err
+	}
+	lo, _ := Min(r.Min, other.Min)
+	hi, _ := Max(r.Max, other.Max)
+	return PriceRange{Min: lo, Max: hi}, nil
+}
+
+// UnmarshalJSON implements encode Unmarshaler and rejects invalid ranges
+func (r *PriceRange) UnmarshalJSON(data []byte) error {
+	// priceRange avoids the recursion into UnmarshalJSON
+	type priceRange PriceRange
+	var decoded priceRange
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if err := PriceRange(decoded).validate(); err != nil {
+		return err
+	}
+	*r = PriceRange(decoded)
+	return nil
+}
+
+func (r PriceRange) guard(other PriceRange) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+	if err := other.validate(); err != nil {
+		return err
+	}
+	if r.Currency() != other.Currency() {
+		return ErrCurrencyMismatch
+	}
+	return nil
+}
+
+func (r PriceRange) validate() error {
+	if r.Min.Currency() != r.Max.Currency() {
+		return newDetailedError(ErrCurrencyMismatch, "price range bounds must have the same currency")
+	}
+	if r.Min.IsGreaterThen(r.Max) {
+		return newDetailedError(ErrInvalidBounds, "price range minimum must not be higher than maximum")
+	}
+	return nil
+}
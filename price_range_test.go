@@ -0,0 +1,79 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eurRange(t *testing.T, min, max int64) PriceRange {
+	t.Helper()
+	r, err := NewPriceRange(NewFromInt(min, 1, "EUR"), NewFromInt(max, 1, "EUR"))
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewPriceRange(t *testing.T) {
+	r, err := NewPriceRange(NewFromInt(10, 1, "EUR"), NewFromInt(10, 1, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, r.IsValid())
+	assert.Equal(t, "EUR", r.Currency())
+
+	_, err = NewPriceRange(NewFromInt(20, 1, "EUR"), NewFromInt(10, 1, "EUR"))
+	assert.Error(t, err)
+
+	_, err = NewPriceRange(NewFromInt(10, 1, "EUR"), NewFromInt(20, 1, "USD"))
+	assert.Error(t, err)
+
+	assert.False(t, PriceRange{Min: NewFromInt(2, 1, "EUR"), Max: NewFromInt(1, 1, "EUR")}.IsValid())
+}
+
+func TestPriceRange_Contains(t *testing.T) {
+	r := eurRange(t, 10, 20)
+	assert.True(t, r.Contains(NewFromInt(10, 1, "EUR")))
+	assert.True(t, r.Contains(NewFromInt(15, 1, "EUR")))
+	assert.True(t, r.Contains(NewFromInt(20, 1, "EUR")))
+	assert.False(t, r.Contains(NewFromInt(21, 1, "EUR")))
+	assert.False(t, r.Contains(NewFromInt(15, 1, "USD")))
+}
+
+func TestPriceRange_Intersect(t *testing.T) {
+	result, err := eurRange(t, 10, 20).Intersect(eurRange(t, 15, 30))
+	require.NoError(t, err)
+	assert.Equal(t, eurRange(t, 15, 20), result)
+
+	result, err = eurRange(t, 10, 20).Intersect(eurRange(t, 20, 30))
+	require.NoError(t, err)
+	assert.Equal(t, eurRange(t, 20, 20), result)
+
+	_, err = eurRange(t, 10, 20).Intersect(eurRange(t, 25, 30))
+	assert.Error(t, err)
+
+	_, err = eurRange(t, 10, 20).Intersect(PriceRange{Min: NewFromInt(1, 1, "USD"), Max: NewFromInt(2, 1, "USD")})
+	assert.Error(t, err)
+}
+
+func TestPriceRange_Union(t *testing.T) {
+	result, err := eurRange(t, 10, 20).Union(eurRange(t, 25, 30))
+	require.NoError(t, err)
+	assert.Equal(t, eurRange(t, 10, 30), result)
+
+	_, err = eurRange(t, 10, 20).Union(PriceRange{Min: NewFromInt(1, 1, "USD"), Max: NewFromInt(2, 1, "USD")})
+	assert.Error(t, err)
+}
+
+func TestPriceRange_JSON(t *testing.T) {
+	data, err := json.Marshal(eurRange(t, 10, 20))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"min":{"amount":"10","currency":"EUR"},"max":{"amount":"20","currency":"EUR"}}`, string(data))
+
+	var decoded PriceRange
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.Min.Equal(NewFromInt(10, 1, "EUR")))
+	assert.True(t, decoded.Max.Equal(NewFromInt(20, 1, "EUR")))
+
+	err = json.Unmarshal([]byte(`{"min":{"amount":"30","currency":"EUR"},"max":{"amount":"20","currency":"EUR"}}`), &decoded)
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -341,6 +342,46 @@ func TestPrice_GetPayableByRoundingMode_RoundingModeHalfDown(t *testing.T) {
 
 }
 
+func TestPrice_GetPayableByRoundingMode_RoundingModeHalfEven(t *testing.T) {
+	tests := []struct {
+		price     float64
+		precision int
+		expected  int64
+		msg       string
+	}{
+		{price: 7.6, precision: 1, expected: 8, msg: "7.6 should be rounded to 8"},
+		{price: 7.5, precision: 1, expected: 8, msg: "7.5 should be rounded to 8"},
+		{price: 6.5, precision: 1, expected: 6, msg: "6.5 should be rounded to 6"},
+		{price: 7.4, precision: 1, expected: 7, msg: "7.4 should be rounded to 7"},
+		{price: 12.345, precision: 100, expected: 1234, msg: "12.345 should be rounded to 12.34"},
+		{price: 12.355, precision: 100, expected: 1236, msg: "12.355 should be rounded to 12.36"},
+		{price: 1.25, precision: 10, expected: 12, msg: "1.25 should be rounded to 1.2"},
+
+		{price: -7.5, precision: 1, expected: -8, msg: "-7.5 should be rounded to -8"},
+		{price: -6.5, precision: 1, expected: -6, msg: "-6.5 should be rounded to -6"},
+		{price: -12.345, precision: 100, expected: -1234, msg: "-12.345 should be rounded to -12.34"},
+
+		{price: 5.5, precision: 1, expected: 6, msg: "5.5 should be rounded to 6"},
+		{price: 2.5, precision: 1, expected: 2, msg: "2.5 should be rounded to 2"},
+		{price: 1.6, precision: 1, expected: 2, msg: "1.6 should be rounded to 2"},
+		{price: 1.1, precision: 1, expected: 1, msg: "1.1 should be rounded to 1"},
+		{price: 1.0, precision: 1, expected: 1, msg: "1.0 should be rounded to 1"},
+		{price: -1.0, precision: 1, expected: -1, msg: "-1.0 should be rounded to -1"},
+		{price: -1.1, precision: 1, expected: -1, msg: "-1.1 should be rounded to -1"},
+		{price: -1.6, precision: 1, expected: -2, msg: "-1.6 should be rounded to -2"},
+		{price: -2.5, precision: 1, expected: -2, msg: "-2.5 should be rounded to -2"},
+		{price: -5.5, precision: 1, expected: -6, msg: "-5.5 should be rounded to -6"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("rounding %f", tt.price), func(t *testing.T) {
+			price := NewFromFloat(tt.price, "EUR")
+
+			payable := price.GetPayableByRoundingMode(RoundingModeHalfEven, tt.precision)
+			assert.Equal(t, NewFromInt(tt.expected, tt.precision, "EUR").Amount(), payable.Amount(), tt.msg)
+		})
+	}
+}
+
 func TestCharges_Add(t *testing.T) {
 	c1 := Charges{}
 
@@ -492,3 +533,891 @@ func TestPrice_Equal(t *testing.T) {
 
 	t.Log("Should be equal of", p.amount.String(), cmp.amount.String(), "🤨")
 }
+
+type fixedRateConverter struct {
+	rate     float64
+	currency string
+}
+
+func (c fixedRateConverter) ToBase(p Price) (Price, error) {
+	if p.Currency() == "XXX" {
+		return Price{}, errors.New("unsupported currency")
+	}
+	return NewFromFloat(p.FloatAmount()*c.rate, c.currency), nil
+}
+
+func TestNewCharge(t *testing.T) {
+	t.Run("value computed by converter", func(t *testing.T) {
+		charge, err := NewCharge(ChargeTypeGiftCard, "GC-1", NewFromInt(500, 1, "points"), fixedRateConverter{rate: 0.01, currency: "EUR"})
+		require.NoError(t, err)
+		assert.Equal(t, ChargeTypeGiftCard, charge.Type)
+		assert.Equal(t, "GC-1", charge.Reference)
+		assert.True(t, charge.Price.Equal(NewFromInt(500, 1, "points")))
+		assert.True(t, charge.Value.GetPayable().Equal(NewFromInt(5, 1, "EUR").GetPayable()))
+	})
+
+	t.Run("nil converter keeps price as value", func(t *testing.T) {
+		charge, err := NewCharge(ChargeTypeMain, "", NewFromInt(1250, 100, "EUR"), nil)
+		require.NoError(t, err)
+		assert.True(t, charge.Value.Equal(charge.Price))
+	})
+
+	t.Run("converter error", func(t *testing.T) {
+		_, err := NewCharge(ChargeTypeMain, "", NewFromInt(1, 1, "XXX"), fixedRateConverter{rate: 1, currency: "EUR"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCharge_SplitInPayables(t *testing.T) {
+	charge := Charge{
+		Type:      ChargeTypeGiftCard,
+		Reference: "GC-1",
+		Price:     NewFromFloat(12.456, "USD"),
+		Value:     NewFromFloat(10.01, "EUR"),
+	}
+
+	charges, err := charge.SplitInPayables(3)
+	require.NoError(t, err)
+	require.Len(t, charges, 3)
+
+	priceSum := NewZero("USD")
+	valueSum := NewZero("EUR")
+	for _, c := range charges {
+		assert.Equal(t, ChargeTypeGiftCard, c.Type)
+		assert.Equal(t, "GC-1", c.Reference)
+		priceSum, _ = priceSum.Add(c.Price)
+		valueSum, _ = valueSum.Add(c.Value)
+	}
+	assert.Equal(t, charge.Price.GetPayable().Amount(), priceSum.GetPayable().Amount())
+	assert.Equal(t, charge.Value.GetPayable().Amount(), valueSum.GetPayable().Amount())
+
+	_, err = charge.SplitInPayables(0)
+	assert.Error(t, err)
+}
+
+func TestCharges_CanonicalBytes(t *testing.T) {
+	build := func(order []Charge) Charges {
+		charges := Charges{}
+		for _, charge := range order {
+			charges = charges.AddCharge(charge)
+		}
+		return charges
+	}
+	main := Charge{Type: ChargeTypeMain, Price: NewFromInt(1050, 100, "EUR"), Value: NewFromInt(1050, 100, "EUR")}
+	giftCard := Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(500, 100, "EUR"), Value: NewFromInt(500, 100, "EUR")}
+	points := Charge{Type: "loyalty", Reference: "A", Price: NewFromInt(300, 1, "points"), Value: NewFromInt(3, 1, "EUR")}
+
+	first, err := build([]Charge{main, giftCard, points}).CanonicalBytes()
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		other, err := build([]Charge{points, main, giftCard}).CanonicalBytes()
+		require.NoError(t, err)
+		assert.Equal(t, first, other)
+	}
+
+	assert.Equal(t, `[{"type":"giftcard","reference":"GC-1","price":{"amount":"5","currency":"EUR"},"value":{"amount":"5","currency":"EUR"}},`+
+		`{"type":"loyalty","reference":"A","price":{"amount":"300","currency":"points"},"value":{"amount":"3","currency":"EUR"}},`+
+		`{"type":"main","reference":"","price":{"amount":"10.5","currency":"EUR"},"value":{"amount":"10.5","currency":"EUR"}}]`, string(first))
+
+	empty, err := Charges{}.CanonicalBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(empty))
+
+	// equal amounts of different precisions result in the same bytes
+	price := NewFromFloat(0.1, "EUR")
+	higherPrecision, err := price.Add(NewFromInt(0, 1, "EUR"))
+	require.NoError(t, err)
+	require.NotEqual(t, price.amount.Prec(), higherPrecision.amount.Prec())
+	first, err = Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: price, Value: price}).CanonicalBytes()
+	require.NoError(t, err)
+	other, err := Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: higherPrecision, Value: higherPrecision}).CanonicalBytes()
+	require.NoError(t, err)
+	assert.Equal(t, string(first), string(other))
+}
+
+func TestPrice_MultiplyInt64(t *testing.T) {
+	price := NewFromInt(25, 100, "EUR")
+
+	result := price.MultiplyInt64(math.MaxInt64)
+	assert.Equal(t, "2305843009213693951.75", result.Amount().Text('f', 2))
+
+	result = NewFromInt(1, 1, "EUR").MultiplyInt64(math.MinInt64)
+	assert.Equal(t, "-9223372036854775808", result.Amount().Text('f', 0))
+
+	assert.True(t, price.MultiplyInt64(3).Equal(price.Multiply(3)))
+}
+
+func TestPrice_MultiplyBigInt(t *testing.T) {
+	qty := new(big.Int).SetUint64(math.MaxUint64)
+	qty.Add(qty, big.NewInt(1)) // 2^64
+
+	result := NewFromInt(3, 1, "EUR").MultiplyBigInt(qty)
+	assert.Equal(t, "55340232221128654848", result.Amount().Text('f', 0))
+
+	result = NewFromInt(25, 100, "EUR").MultiplyBigInt(big.NewInt(4))
+	assert.True(t, result.Equal(NewFromInt(1, 1, "EUR")))
+}
+
+func TestPrice_Display(t *testing.T) {
+	assert.Equal(t, "12.35 EUR", NewFromFloat(12.34567, "EUR").Display())
+	assert.Equal(t, "-0.50 EUR", NewFromFloat(-0.5, "EUR").Display())
+	assert.Equal(t, "1235 JPY", NewFromFloat(1234.5, "JPY").Display())
+	assert.Equal(t, "1.235 BHD", NewFromFloat(1.2345, "BHD").Display())
+	assert.Equal(t, "99 points", NewFromFloat(99.9, "points").Display())
+	assert.Equal(t, "0.00", Price{}.Display())
+}
+
+func TestCharges_JSON(t *testing.T) {
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(1050, 100, "EUR"), Value: NewFromInt(1050, 100, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(5, 1, "EUR"), Value: NewFromInt(5, 1, "EUR")})
+
+	data, err := json.Marshal(charges)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"type":"giftcard","reference":"GC-1","price":{"amount":"5","currency":"EUR"},"value":{"amount":"5","currency":"EUR"}},`+
+		`{"type":"main","price":{"amount":"10.5","currency":"EUR"},"value":{"amount":"10.5","currency":"EUR"}}]`, string(data))
+
+	var decoded Charges
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Len(t, decoded.GetAllCharges(), 2)
+	giftCard, found := decoded.GetByChargeQualifier(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"})
+	assert.True(t, found)
+	assert.True(t, giftCard.Price.Equal(NewFromInt(5, 1, "EUR")))
+	assert.Equal(t, "GC-1", giftCard.Reference)
+
+	type order struct {
+		Charges *Charges `json:"charges"`
+	}
+	data, err = json.Marshal(order{Charges: &Charges{}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"charges":[]}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"main"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"main","price":{"amount":"1","currency":"EUR"}},{"type":"main","price":{"amount":"1","currency":"USD"}}]`), &decoded))
+}
+
+func TestCharges_ValueScan(t *testing.T) {
+	charges := Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(1050, 100, "EUR"), Value: NewFromInt(1050, 100, "EUR")})
+
+	value, err := charges.Value()
+	require.NoError(t, err)
+
+	var scanned Charges
+	require.NoError(t, scanned.Scan(value))
+	charge, found := scanned.GetByType(ChargeTypeMain)
+	assert.True(t, found)
+	assert.True(t, charge.Price.Equal(NewFromInt(1050, 100, "EUR")))
+
+	var fromString Charges
+	require.NoError(t, fromString.Scan(string(value.([]byte))))
+	assert.True(t, fromString.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(1050, 100, "EUR")))
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.HasType(ChargeTypeMain))
+
+	assert.Error(t, scanned.Scan("not json"))
+	assert.Error(t, scanned.Scan(int64(1)))
+}
+
+func TestCharges_MarshalBinaryForGob(t *testing.T) {
+	type (
+		SomeTypeWithCharges struct {
+			Charges Charges
+		}
+	)
+	var network bytes.Buffer
+	enc := gob.NewEncoder(&network)
+	dec := gob.NewDecoder(&network)
+
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(1111, 100, "EUR"), Value: NewFromInt(1111, 100, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(500, 100, "EUR"), Value: NewFromInt(500, 100, "EUR")})
+
+	err := enc.Encode(&SomeTypeWithCharges{Charges: charges})
+	if err != nil {
+		t.Fatal("encode error:", err)
+	}
+	var received SomeTypeWithCharges
+	err = dec.Decode(&received)
+	if err != nil {
+		t.Fatal("decode error:", err)
+	}
+
+	assert.Len(t, received.Charges.GetAllCharges(), 2)
+	giftCard, found := received.Charges.GetByChargeQualifier(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"})
+	assert.True(t, found)
+	assert.Equal(t, 5.0, giftCard.Price.FloatAmount())
+}
+
+func TestCharges_Immutable(t *testing.T) {
+	main := Charge{Type: ChargeTypeMain, Price: NewFromInt(10, 1, "EUR"), Value: NewFromInt(10, 1, "EUR")}
+	giftCard := Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(5, 1, "EUR"), Value: NewFromInt(5, 1, "EUR")}
+
+	c1 := Charges{}.AddCharge(main)
+	alias := c1
+	c2 := Charges{}.AddCharge(main).AddCharge(giftCard)
+
+	c1and2 := c1.Add(c2)
+	assert.Len(t, c1and2.GetAllCharges(), 2)
+	assert.True(t, c1and2.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(20, 1, "EUR")))
+
+	// neither the receiver, its aliases nor the argument are modified
+	assert.Len(t, c1.GetAllCharges(), 1)
+	assert.True(t, c1.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+	assert.True(t, alias.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+	assert.True(t, c2.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+
+	withCharge := c1.AddCharge(main)
+	assert.True(t, withCharge.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(20, 1, "EUR")))
+	assert.True(t, c1.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+
+	multiplied := c1.Mul(3)
+	assert.True(t, multiplied.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(30, 1, "EUR")))
+	assert.True(t, c1.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+
+	capped, err := c1.Cap(ChargeQualifier{Type: ChargeTypeMain}, NewFromInt(4, 1, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, capped.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(4, 1, "EUR")))
+	assert.True(t, c1.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(10, 1, "EUR")))
+
+	all := c1.GetAllCharges()
+	delete(all, ChargeQualifier{Type: ChargeTypeMain})
+	assert.True(t, c1.HasType(ChargeTypeMain))
+}
+
+func TestCharges_CapNegative(t *testing.T) {
+	charges := Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: NewZero("EUR"), Value: NewFromInt(5, 1, "EUR")})
+
+	result, err := charges.Cap(ChargeQualifier{Type: ChargeTypeMain}, NewFromInt(-1, 1, "EUR"))
+	assert.Error(t, err)
+	assert.True(t, result.GetByTypeForced(ChargeTypeMain).Value.Equal(NewFromInt(5, 1, "EUR")))
+
+	result, err = charges.Cap(ChargeQualifier{Type: ChargeTypeMain}, NewZero("EUR"))
+	require.NoError(t, err)
+	assert.True(t, result.GetByTypeForced(ChargeTypeMain).Value.Equal(NewFromInt(5, 1, "EUR")))
+}
+
+func TestCharges_Sub(t *testing.T) {
+	main := Charge{Type: ChargeTypeMain, Price: NewFromInt(30, 1, "EUR"), Value: NewFromInt(30, 1, "EUR")}
+	giftCard := Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(20, 1, "EUR"), Value: NewFromInt(20, 1, "EUR")}
+	charges := Charges{}.AddCharge(main).AddCharge(giftCard)
+
+	refund := Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(10, 1, "EUR"), Value: NewFromInt(10, 1, "EUR")}).AddCharge(giftCard)
+	result, err := charges.Sub(refund)
+	require.NoError(t, err)
+
+	assert.True(t, result.GetByTypeForced(ChargeTypeMain).Price.Equal(NewFromInt(20, 1, "EUR")))
+	assert.True(t, result.GetByTypeForced(ChargeTypeMain).Value.Equal(NewFromInt(20, 1, "EUR")))
+	assert.False(t, result.HasType(ChargeTypeGiftCard), "charges subtracted to zero are removed")
+	assert.True(t, charges.HasType(ChargeTypeGiftCard), "receiver is not modified")
+
+	result, err = Charges{}.Sub(Charges{}.AddCharge(giftCard))
+	require.NoError(t, err)
+	assert.True(t, result.GetByTypeForced(ChargeTypeGiftCard).Price.Equal(NewFromInt(-20, 1, "EUR")))
+
+	_, err = charges.Sub(Charges{}.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(10, 1, "USD")}))
+	assert.Error(t, err)
+}
+
+func TestCharges_StrictCurrencyGuard(t *testing.T) {
+	SetStrictCurrencyGuard(true)
+	t.Cleanup(func() { SetStrictCurrencyGuard(false) })
+
+	charges := Charges{}.
+		AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(200, 1, "EUR"), Value: NewFromInt(200, 1, "EUR")}).
+		AddCharge(Charge{Type: ChargeTypeMain, Reference: "ABC123", Price: NewFromInt(100, 1, "EUR"), Value: NewFromInt(100, 1, "EUR")})
+
+	charge, found := charges.GetByType(ChargeTypeMain)
+	require.True(t, found)
+	assert.Equal(t, ChargeTypeMain, charge.Type)
+	assert.Empty(t, charge.Reference)
+	assert.True(t, charge.Price.Equal(NewFromInt(300, 1, "EUR")))
+	assert.True(t, charge.Value.Equal(NewFromInt(300, 1, "EUR")))
+
+	forced := charges.GetByTypeForced(ChargeTypeMain)
+	assert.True(t, forced.Price.Equal(NewFromInt(300, 1, "EUR")))
+
+	giftCard := Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(20, 1, "EUR"), Value: NewFromInt(20, 1, "EUR")}
+	result, err := charges.Sub(Charges{}.AddCharge(giftCard))
+	require.NoError(t, err)
+	assert.True(t, result.GetByTypeForced(ChargeTypeGiftCard).Price.Equal(NewFromInt(-20, 1, "EUR")))
+	assert.True(t, result.GetByTypeForced(ChargeTypeGiftCard).Value.Equal(NewFromInt(-20, 1, "EUR")))
+}
+
+func TestCharges_RemoveCharge(t *testing.T) {
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(30, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(20, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-2", Price: NewFromInt(10, 1, "EUR")})
+
+	removed := charges.RemoveCharge(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"})
+	assert.Len(t, removed.GetAllCharges(), 2)
+	assert.False(t, removed.HasChargeQualifier(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "GC-1"}))
+	assert.Len(t, charges.GetAllCharges(), 3)
+
+	removed = charges.RemoveByType(ChargeTypeGiftCard)
+	assert.Len(t, removed.GetAllCharges(), 1)
+	assert.True(t, removed.HasType(ChargeTypeMain))
+	assert.Len(t, charges.GetAllCharges(), 3)
+}
+
+func TestCharges_Filter(t *testing.T) {
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(30, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromInt(20, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-2", Price: NewFromInt(10, 1, "EUR")})
+
+	withoutGiftCards := charges.Filter(func(qualifier ChargeQualifier, _ Charge) bool {
+		return qualifier.Type != ChargeTypeGiftCard
+	})
+	assert.Len(t, withoutGiftCards.GetAllCharges(), 1)
+	assert.True(t, withoutGiftCards.HasType(ChargeTypeMain))
+	assert.Len(t, charges.GetAllCharges(), 3)
+
+	aboveFifteen := charges.Filter(func(_ ChargeQualifier, charge Charge) bool {
+		return charge.Price.IsGreaterThen(NewFromInt(15, 1, "EUR"))
+	})
+	assert.Len(t, aboveFifteen.GetAllCharges(), 2)
+}
+
+func TestCharges_Map(t *testing.T) {
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromFloat(30.123, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeGiftCard, Reference: "GC-1", Price: NewFromFloat(20.555, "EUR")})
+
+	payable := charges.Map(Charge.GetPayable)
+	assert.Equal(t, "30.120", payable.GetByTypeForced(ChargeTypeMain).Price.Amount().Text('f', 3))
+	assert.Equal(t, "20.560", payable.GetByTypeForced(ChargeTypeGiftCard).Price.Amount().Text('f', 3))
+	assert.Equal(t, "30.123", charges.GetByTypeForced(ChargeTypeMain).Price.Amount().Text('f', 3))
+}
+
+func TestCharges_Items(t *testing.T) {
+	charges := Charges{}
+	charges = charges.AddCharge(Charge{Type: "type-b", Reference: "2", Price: NewFromInt(1, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: ChargeTypeMain, Price: NewFromInt(2, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: "type-b", Reference: "1", Price: NewFromInt(3, 1, "EUR")})
+	charges = charges.AddCharge(Charge{Type: "type-a", Price: NewFromInt(4, 1, "EUR")})
+
+	expected := []ChargeQualifier{
+		{Type: ChargeTypeMain},
+		{Type: "type-a"},
+		{Type: "type-b", Reference: "1"},
+		{Type: "type-b", Reference: "2"},
+	}
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, charges.OrderedQualifiers())
+
+		items := charges.Items()
+		require.Len(t, items, 4)
+		for j, item := range items {
+			assert.Equal(t, expected[j].Type, item.Type)
+			assert.Equal(t, expected[j].Reference, item.Reference)
+		}
+	}
+
+	assert.Empty(t, Charges{}.Items())
+	assert.Empty(t, Charges{}.OrderedQualifiers())
+}
+
+func TestCharge_Sub(t *testing.T) {
+	charge := Charge{Type: ChargeTypeMain, Price: NewFromInt(100, 1, "points"), Value: NewFromInt(10, 1, "EUR")}
+
+	refunded, err := charge.Sub(Charge{Type: ChargeTypeMain, Price: NewFromInt(40, 1, "points"), Value: NewFromInt(4, 1, "EUR")})
+	require.NoError(t, err)
+	assert.True(t, refunded.Price.Equal(NewFromInt(60, 1, "points")))
+	assert.True(t, refunded.Value.Equal(NewFromInt(6, 1, "EUR")))
+	assert.Equal(t, ChargeTypeMain, refunded.Type)
+
+	_, err = charge.Sub(Charge{Type: ChargeTypeGiftCard, Price: NewFromInt(40, 1, "points")})
+	assert.Error(t, err)
+
+	_, err = charge.Sub(Charge{Type: ChargeTypeMain, Price: NewFromInt(40, 1, "EUR")})
+	assert.Error(t, err)
+}
+
+type pointsConverter struct{}
+
+func (pointsConverter) Convert(p Price, to string) (Price, error) {
+	if p.Currency() != "points" || to != "EUR" {
+		return Price{}, errors.New("unsupported conversion")
+	}
+	return NewFromBigFloat(*new(big.Float).Quo(p.Amount(), big.NewFloat(100)), to), nil
+}
+
+func TestCharge_WithConvertedValue(t *testing.T) {
+	charge := Charge{Type: "loyalty", Price: NewFromInt(500, 1, "points")}
+
+	converted, err := charge.WithConvertedValue(pointsConverter{}, "EUR")
+	require.NoError(t, err)
+	assert.True(t, converted.Value.Equal(NewFromInt(5, 1, "EUR")))
+	assert.True(t, converted.Price.Equal(charge.Price))
+	assert.True(t, charge.Value.IsZero(), "original charge is not modified")
+
+	main := Charge{Type: ChargeTypeMain, Price: NewFromInt(10, 1, "EUR")}
+	converted, err = main.WithConvertedValue(pointsConverter{}, "EUR")
+	require.NoError(t, err)
+	assert.True(t, converted.Value.Equal(main.Price), "prices in base currency are not converted")
+
+	_, err = charge.WithConvertedValue(pointsConverter{}, "USD")
+	assert.Error(t, err)
+
+	created, err := NewCharge("loyalty", "", NewFromInt(500, 1, "points"), BaseConverter(pointsConverter{}, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, created.Value.Equal(NewFromInt(5, 1, "EUR")))
+}
+
+func TestChargeQualifier_String(t *testing.T) {
+	tests := []struct {
+		qualifier ChargeQualifier
+		expected  string
+	}{
+		{qualifier: ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "ABC123"}, expected: "giftcard:ABC123"},
+		{qualifier: ChargeQualifier{Type: ChargeTypeMain}, expected: "main"},
+		{qualifier: ChargeQualifier{Type: "type:x", Reference: "ref:1"}, expected: "type%3Ax:ref:1"},
+		{qualifier: ChargeQualifier{Type: "100%"}, expected: "100%25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.qualifier.String())
+
+			parsed, err := ParseChargeQualifier(tt.expected)
+			require.NoError(t, err)
+			assert.Equal(t, tt.qualifier, parsed)
+		})
+	}
+
+	_, err := ParseChargeQualifier("")
+	assert.Error(t, err)
+	_, err = ParseChargeQualifier(":ref")
+	assert.Error(t, err)
+}
+
+func TestChargeQualifier_JSONKey(t *testing.T) {
+	byQualifier := map[ChargeQualifier]string{
+		{Type: ChargeTypeGiftCard, Reference: "ABC123"}: "gift card",
+		{Type: ChargeTypeMain}:                          "main",
+	}
+
+	data, err := json.Marshal(byQualifier)
+	require.NoError(t, err)
+	assert.Equal(t, `{"giftcard:ABC123":"gift card","main":"main"}`, string(data))
+
+	decoded := make(map[ChargeQualifier]string)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, byQualifier, decoded)
+}
+
+func TestChargeQualifier_JSONValue(t *testing.T) {
+	type payment struct {
+		Qualifier ChargeQualifier `json:"qualifier"`
+	}
+	original := payment{Qualifier: ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "ABC123"}}
+
+	// values keep the object form of older versions
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	assert.Equal(t, `{"qualifier":{"Type":"giftcard","Reference":"ABC123"}}`, string(data))
+
+	var decoded payment
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original, decoded)
+
+	decoded = payment{}
+	require.NoError(t, json.Unmarshal([]byte(`{"qualifier":"giftcard:ABC123"}`), &decoded))
+	assert.Equal(t, original, decoded)
+	assert.Error(t, json.Unmarshal([]byte(`{"qualifier":":ABC123"}`), &decoded))
+}
+
+func TestCharge_Metadata(t *testing.T) {
+	charges := Charges{}.AddCharge(Charge{
+		Type:      ChargeTypeGiftCard,
+		Reference: "ABC123",
+		Price:     NewFromInt(5, 1, "EUR"),
+		Value:     NewFromInt(5, 1, "EUR"),
+		Metadata:  map[string]string{"provider": "acme"},
+	})
+
+	data, err := json.Marshal(charges)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"metadata":{"provider":"acme"}`)
+
+	var decoded Charges
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "acme", decoded.GetByChargeQualifierForced(ChargeQualifier{Type: ChargeTypeGiftCard, Reference: "ABC123"}).Metadata["provider"])
+}
+
+func TestMinMax(t *testing.T) {
+	low := NewFromFloat(1.5, "EUR")
+	high := NewFromFloat(2.5, "EUR")
+
+	result, err := Min(high, low)
+	require.NoError(t, err)
+	assert.True(t, result.Equal(low))
+
+	result, err = Max(low, high)
+	require.NoError(t, err)
+	assert.True(t, result.Equal(high))
+
+	_, err = Min(low, NewFromFloat(1, "USD"))
+	assert.Error(t, err)
+
+	_, err = Max(low, NewFromFloat(1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_Clamp(t *testing.T) {
+	lo := NewFromFloat(10, "EUR")
+	hi := NewFromFloat(20, "EUR")
+
+	tests := []struct {
+		name  string
+		price Price
+		want  Price
+	}{
+		{name: "below", price: NewFromFloat(5, "EUR"), want: lo},
+		{name: "inside", price: NewFromFloat(15, "EUR"), want: NewFromFloat(15, "EUR")},
+		{name: "above", price: NewFromFloat(25, "EUR"), want: hi},
+		{name: "on bound", price: NewFromFloat(20, "EUR"), want: hi},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.price.Clamp(lo, hi)
+			require.NoError(t, err)
+			assert.True(t, result.Equal(tt.want))
+		})
+	}
+
+	_, err := NewFromFloat(5, "EUR").Clamp(hi, lo)
+	assert.Error(t, err)
+
+	_, err = NewFromFloat(5, "USD").Clamp(lo, hi)
+	assert.Error(t, err)
+
+	_, err = NewFromFloat(5, "EUR").Clamp(lo, NewFromFloat(20, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_Abs(t *testing.T) {
+	negative := NewFromFloat(-12.5, "EUR")
+	assert.True(t, negative.Abs().Equal(NewFromFloat(12.5, "EUR")))
+	assert.True(t, negative.Equal(NewFromFloat(-12.5, "EUR")), "original is not changed")
+	assert.True(t, NewFromFloat(3, "EUR").Abs().Equal(NewFromFloat(3, "EUR")))
+	assert.True(t, NewZero("EUR").Abs().IsZero())
+}
+
+func TestPrice_Mod(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   Price
+		divisor Price
+		want    string
+	}{
+		{name: "bills", price: NewFromInt(5730, 100, "EUR"), divisor: NewFromInt(20, 1, "EUR"), want: "17.300"},
+		{name: "coins", price: NewFromInt(1730, 100, "EUR"), divisor: NewFromInt(50, 100, "EUR"), want: "0.300"},
+		{name: "no remainder", price: NewFromInt(60, 1, "EUR"), divisor: NewFromInt(20, 1, "EUR"), want: "0.000"},
+		{name: "negative", price: NewFromInt(-5730, 100, "EUR"), divisor: NewFromInt(20, 1, "EUR"), want: "-17.300"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.price.Mod(tt.divisor)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Amount().Text('f', 3))
+			assert.Equal(t, "EUR", result.Currency())
+		})
+	}
+
+	_, err := NewFromInt(1, 1, "EUR").Mod(NewZero("EUR"))
+	assert.Error(t, err)
+
+	_, err = NewFromInt(1, 1, "EUR").Mod(NewFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_DivMod(t *testing.T) {
+	tests := []struct {
+		name          string
+		price         Price
+		qty           int
+		wantQuotient  string
+		wantRemainder string
+	}{
+		{name: "remainder", price: NewFromInt(100, 1, "EUR"), qty: 3, wantQuotient: "33.33", wantRemainder: "0.010"},
+		{name: "even", price: NewFromInt(100, 1, "EUR"), qty: 4, wantQuotient: "25.00", wantRemainder: "0.000"},
+		{name: "unpayable price", price: NewFromInt(10005, 1000, "EUR"), qty: 2, wantQuotient: "5.00", wantRemainder: "0.005"},
+		{name: "negative", price: NewFromInt(-100, 1, "EUR"), qty: 3, wantQuotient: "-33.33", wantRemainder: "-0.010"},
+		{name: "zero decimal currency", price: NewFromInt(1000, 1, "JPY"), qty: 3, wantQuotient: "333.00", wantRemainder: "1.000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotient, remainder, err := tt.price.DivMod(tt.qty)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuotient, quotient.Amount().Text('f', 2))
+			assert.Equal(t, tt.wantRemainder, remainder.Amount().Text('f', 3))
+
+			total := quotient.MultiplyInt64(int64(tt.qty)).ForceAdd(remainder)
+			assert.True(t, total.LikelyEqual(tt.price))
+		})
+	}
+
+	_, _, err := NewFromInt(1, 1, "EUR").DivMod(0)
+	assert.Error(t, err)
+}
+
+func TestPrice_MultiplyFloat(t *testing.T) {
+	perKg := NewFromInt(1299, 100, "EUR")
+
+	result := perKg.MultiplyFloat(0.1)
+	assert.Equal(t, "1.299", result.Amount().Text('f', 3))
+	assert.Equal(t, "1.30", result.GetPayable().Amount().Text('f', 2))
+
+	result = perKg.MultiplyFloat(2.5)
+	assert.Equal(t, "32.48", result.GetPayable().Amount().Text('f', 2))
+
+	assert.True(t, perKg.MultiplyFloat(math.NaN()).IsZero())
+	assert.True(t, perKg.MultiplyFloat(math.Inf(1)).IsZero())
+	assert.Equal(t, "EUR", perKg.MultiplyFloat(math.NaN()).Currency())
+}
+
+func TestPrice_MultiplyRat(t *testing.T) {
+	result := NewFromInt(9, 1, "EUR").MultiplyRat(big.NewRat(1, 3))
+	assert.Equal(t, "3", result.Amount().Text('f', -1))
+
+	result = NewFromInt(10, 1, "EUR").MultiplyRat(big.NewRat(-3, 4))
+	assert.Equal(t, "-7.5", result.Amount().Text('f', -1))
+
+	assert.True(t, NewFromInt(10, 1, "EUR").MultiplyRat(nil).IsZero())
+}
+
+func TestPrice_DividedWithRemainder(t *testing.T) {
+	tests := []struct {
+		name          string
+		price         Price
+		qty           int
+		wantQuotient  string
+		wantRemainder string
+	}{
+		{name: "remainder", price: NewFromInt(100, 1, "EUR"), qty: 3, wantQuotient: "33.33", wantRemainder: "0.01"},
+		{name: "rounded up", price: NewFromInt(200, 1, "EUR"), qty: 3, wantQuotient: "66.67", wantRemainder: "-0.01"},
+		{name: "even", price: NewFromInt(100, 1, "EUR"), qty: 4, wantQuotient: "25.00", wantRemainder: "0.00"},
+		{name: "unpayable price", price: NewFromInt(10006, 1000, "EUR"), qty: 2, wantQuotient: "5.01", wantRemainder: "-0.01"},
+		{name: "zero qty", price: NewFromInt(10, 1, "EUR"), qty: 0, wantQuotient: "0.00", wantRemainder: "10.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotient, remainder := tt.price.DividedWithRemainder(tt.qty)
+			assert.Equal(t, tt.wantQuotient, quotient.Amount().Text('f', 2))
+			assert.Equal(t, tt.wantRemainder, remainder.Amount().Text('f', 2))
+			assert.True(t, quotient.IsPayable())
+			assert.True(t, remainder.IsPayable())
+
+			total := quotient.MultiplyInt64(int64(tt.qty)).ForceAdd(remainder)
+			assert.True(t, total.LikelyEqual(tt.price.GetPayable()))
+		})
+	}
+}
+
+func TestPrice_PercentageOf(t *testing.T) {
+	percentage, err := NewFromInt(125, 10, "EUR").PercentageOf(NewFromInt(100, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "12.5", percentage.Text('f', -1))
+
+	percentage, err = NewFromInt(1, 1, "EUR").PercentageOf(NewFromInt(3, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "33.3333", percentage.Text('f', 4))
+
+	_, err = NewFromInt(1, 1, "EUR").PercentageOf(NewZero("EUR"))
+	assert.Error(t, err)
+
+	_, err = NewFromInt(1, 1, "EUR").PercentageOf(NewFromInt(1, 1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_RatioTo(t *testing.T) {
+	ratio, err := NewFromInt(40, 1, "EUR").RatioTo(NewFromInt(50, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.8", ratio.Text('f', -1))
+
+	_, err = NewFromInt(40, 1, "EUR").RatioTo(NewZero("EUR"))
+	assert.Error(t, err)
+
+	_, err = NewFromInt(40, 1, "EUR").RatioTo(NewFromInt(50, 1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_Cmp(t *testing.T) {
+	result, err := NewFromInt(1, 1, "EUR").Cmp(NewFromInt(2, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, -1, result)
+
+	result, err = NewFromInt(2, 1, "EUR").Cmp(NewFromInt(2, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, result)
+
+	result, err = NewFromInt(3, 1, "EUR").Cmp(NewFromInt(2, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	_, err = NewFromInt(3, 1, "EUR").Cmp(NewFromInt(2, 1, "USD"))
+	assert.Error(t, err)
+}
+
+func TestPrice_ComparisonAliases(t *testing.T) {
+	low := NewFromInt(1, 1, "EUR")
+	high := NewFromInt(2, 1, "EUR")
+	other := NewFromInt(1, 1, "USD")
+
+	assert.True(t, low.IsLessThan(high))
+	assert.False(t, high.IsLessThan(low))
+	assert.True(t, high.IsGreaterThan(low))
+	assert.False(t, low.IsGreaterThan(high))
+
+	assert.True(t, low.IsLessThanOrEqual(high))
+	assert.True(t, low.IsLessThanOrEqual(low))
+	assert.False(t, high.IsLessThanOrEqual(low))
+	assert.False(t, low.IsLessThanOrEqual(other))
+
+	assert.True(t, high.IsGreaterThanOrEqual(low))
+	assert.True(t, high.IsGreaterThanOrEqual(high))
+	assert.False(t, low.IsGreaterThanOrEqual(high))
+	assert.False(t, low.IsGreaterThanOrEqual(other))
+}
+
+func TestPrice_Between(t *testing.T) {
+	lo := NewFromInt(10, 1, "EUR")
+	hi := NewFromInt(20, 1, "EUR")
+
+	tests := []struct {
+		name      string
+		price     Price
+		inclusive bool
+		want      bool
+	}{
+		{name: "inside", price: NewFromInt(15, 1, "EUR"), inclusive: false, want: true},
+		{name: "below", price: NewFromInt(5, 1, "EUR"), inclusive: true, want: false},
+		{name: "above", price: NewFromInt(25, 1, "EUR"), inclusive: true, want: false},
+		{name: "lower bound inclusive", price: lo, inclusive: true, want: true},
+		{name: "lower bound exclusive", price: lo, inclusive: false, want: false},
+		{name: "upper bound inclusive", price: hi, inclusive: true, want: true},
+		{name: "upper bound exclusive", price: hi, inclusive: false, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.price.Between(lo, hi, tt.inclusive)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	_, err := NewFromInt(15, 1, "USD").Between(lo, hi, true)
+	assert.Error(t, err)
+
+	_, err = NewFromInt(15, 1, "EUR").Between(hi, lo, true)
+	assert.Error(t, err)
+}
+
+func TestPrice_NaNAndInf(t *testing.T) {
+	_, err := NewFromFloatChecked(math.NaN(), "EUR")
+	assert.Error(t, err)
+	_, err = NewFromFloatChecked(math.Inf(-1), "EUR")
+	assert.Error(t, err)
+	p, err := NewFromFloatChecked(1.5, "EUR")
+	require.NoError(t, err)
+	assert.True(t, p.Equal(NewFromFloat(1.5, "EUR")))
+
+	_, err = NewFromBigFloatChecked(*new(big.Float).SetInf(false), "EUR")
+	assert.Error(t, err)
+	_, err = NewFromBigFloatChecked(*big.NewFloat(1.5), "EUR")
+	assert.NoError(t, err)
+
+	inf := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+	finite := NewFromInt(10, 1, "EUR")
+
+	_, err = inf.MultiplyChecked(2)
+	assert.Error(t, err)
+	multiplied, err := finite.MultiplyChecked(2)
+	require.NoError(t, err)
+	assert.True(t, multiplied.Equal(NewFromInt(20, 1, "EUR")))
+	assert.NotPanics(t, func() {
+		assert.True(t, inf.Multiply(0).IsZero())
+	})
+
+	_, err = finite.DiscountedChecked(math.NaN())
+	assert.Error(t, err)
+	_, err = finite.DiscountedChecked(math.Inf(1))
+	assert.Error(t, err)
+	_, err = inf.DiscountedChecked(10)
+	assert.Error(t, err)
+	discounted, err := finite.DiscountedChecked(10)
+	require.NoError(t, err)
+	assert.True(t, discounted.Equal(NewFromInt(9, 1, "EUR")))
+
+	_, err = finite.TaxedChecked(*new(big.Float).SetInf(false))
+	assert.Error(t, err)
+	_, err = inf.TaxedChecked(*big.NewFloat(19))
+	assert.Error(t, err)
+	taxed, err := finite.TaxedChecked(*big.NewFloat(19))
+	require.NoError(t, err)
+	assert.True(t, taxed.Equal(NewFromInt(119, 10, "EUR")))
+}
+
+func TestPrice_GetPayableAboveMaxInt64(t *testing.T) {
+	parse := func(s string) Price {
+		amount, _, err := new(big.Float).SetPrec(200).Parse(s, 10)
+		require.NoError(t, err)
+		return NewFromBigFloat(*amount, "EUR")
+	}
+
+	tests := []struct {
+		name   string
+		amount string
+		mode   RoundingMode
+		want   string
+	}{
+		{name: "half up", amount: "123456789012345678901.125", mode: RoundingModeHalfUp, want: "123456789012345678901.13"},
+		{name: "half down", amount: "123456789012345678901.125", mode: RoundingModeHalfDown, want: "123456789012345678901.12"},
+		{name: "half even", amount: "123456789012345678901.125", mode: RoundingModeHalfEven, want: "123456789012345678901.12"},
+		{name: "floor", amount: "123456789012345678901.129", mode: RoundingModeFloor, want: "123456789012345678901.12"},
+		{name: "ceil", amount: "123456789012345678901.121", mode: RoundingModeCeil, want: "123456789012345678901.13"},
+		{name: "negative half up", amount: "-123456789012345678901.125", mode: RoundingModeHalfUp, want: "-123456789012345678901.13"},
+		{name: "negative floor", amount: "-123456789012345678901.121", mode: RoundingModeFloor, want: "-123456789012345678901.13"},
+		{name: "negative ceil", amount: "-123456789012345678901.129", mode: RoundingModeCeil, want: "-123456789012345678901.12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parse(tt.amount).GetPayableByRoundingMode(tt.mode, 100)
+			assert.Equal(t, tt.want, result.Amount().Text('f', 2))
+			assert.Equal(t, "EUR", result.Currency())
+		})
+	}
+}
+
+func TestPrice_Scan(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  Price
+	}{
+		{name: "json bytes", value: []byte(`{"amount":"12.34","currency":"EUR"}`), want: NewFromInt(1234, 100, "EUR")},
+		{name: "json string", value: `{"amount":"12.34","currency":"EUR"}`, want: NewFromInt(1234, 100, "EUR")},
+		{name: "decimal string", value: "12.34", want: NewFromInt(1234, 100, "USD")},
+		{name: "decimal bytes", value: []byte("-0.5"), want: NewFromInt(-5, 10, "USD")},
+		{name: "int", value: int64(42), want: NewFromInt(42, 1, "USD")},
+		{name: "float", value: 1.5, want: NewFromInt(15, 10, "USD")},
+		{name: "null", value: nil, want: NewZero("USD")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanned := NewZero("USD")
+			require.NoError(t, scanned.Scan(tt.value))
+			assert.True(t, tt.want.LikelyEqual(scanned), "got %s", scanned.String())
+			assert.Equal(t, tt.want.Currency(), scanned.Currency())
+		})
+	}
+
+	var scanned Price
+	assert.Error(t, scanned.Scan(true))
+	assert.Error(t, scanned.Scan("abc"))
+	assert.Error(t, scanned.Scan("Inf"))
+	assert.Error(t, scanned.Scan(math.Inf(1)))
+}
@@ -0,0 +1,143 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// Prices is a list of prices in the same currency
+type Prices []Price
+
+// Compare returns -1 if a is lower than b, 1 if a is higher than b and 0 if both are equal.
+// Prices in different currencies are ordered by currency code first and by amount second,
+// so mixed lists are grouped per currency. The signature matches slices.SortFunc.
+func Compare(a, b Price) int {
+	if a.currency != b.currency {
+		if a.currency < b.currency {
+			return -1
+		}
+		return 1
+	}
+	return a.amount.Cmp(&b.amount)
+}
+
+// Sort sorts the prices ascending in place, see Compare for the order of mixed currencies
+func (ps Prices) Sort() {
+	sort.SliceStable(ps, func(i, j int) bool {
+		return Compare(ps[i], ps[j]) < 0
+	})
+}
+
+// SortDesc sorts the prices descending in place, see Compare for the order of mixed currencies
+func (ps Prices) SortDesc() {
+	sort.SliceStable(ps, func(i, j int) bool {
+		return Compare(ps[i], ps[j]) > 0
+	})
+}
+
+// Sum returns the sum of all prices, see SumAll
+func (ps Prices) Sum() (Price, error) {
+	return SumAll(ps...)
+}
+
+// Min returns the lowest price
+func (ps Prices) Min() (Price, error) {
+	return ps.pick(func(candidate, current Price) bool {
+		return candidate.amount.Cmp(&current.amount) < 0
+	})
+}
+
+// Max returns the highest price
+func (ps Prices) Max() (Price, error) {
+	return ps.pick(func(candidate, current Price) bool {
+		return candidate.amount.Cmp(&current.amount) > 0
+	})
+}
+
+// Average returns the payable arithmetic mean of all prices
+func (ps Prices) Average() (Price, error) {
+	sum, err := ps.Sum()
+	if err != nil {
+		return sum, err
+	}
+	return sum.mulRat(big.NewRat(1, int64(len(ps)))).GetPayable(), nil
+}
+
+// Median returns the payable median, for an even number of prices the mean of the two middle prices
+func (ps Prices) Median() (Price, error) {
+	if err := ps.guard(); err != nil {
+		return NewZero(""), err
+	}
+	sorted := make(Prices, len(ps))
+	copy(sorted, ps)
+	sorted.Sort()
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle].GetPayable(), nil
+	}
+	sum, err := sorted[middle-1].Add(sorted[middle])
+	if err != nil {
+		return sum, err
+	}
+	return sum.mulRat(big.NewRat(1, 2)).GetPayable(), nil
+}
+
+// WeightedAverage returns the payable average with each price weighted by the weight at the same index,
+// e.g. with the sold quantities of the variants as weights
+func (ps Prices) WeightedAverage(weights []int64) (Price, error) {
+	if err := ps.guard(); err != nil {
+		return NewZero(""), err
+	}
+	if len(weights) != len(ps) {
+		return NewZero(""), errors.New("number of weights must match the number of prices")
+	}
+
+	total := new(big.Rat)
+	weightSum := new(big.Rat)
+	for i, price := range ps {
+		if weights[i] < 0 {
+			return NewZero(""), errors.New("weights must not be negative")
+		}
+		if price.amount.IsInf() {
+			return NewZero(""), newDetailedError(ErrNotFinite, "cannot average infinite amounts")
+		}
+		weight := new(big.Rat).SetInt64(weights[i])
+		amount, _ := price.amount.Rat(nil)
+		total.Add(total, amount.Mul(amount, weight))
+		weightSum.Add(weightSum, weight)
+	}
+	if weightSum.Sign() == 0 {
+		return NewZero(""), errors.New("sum of weights must be higher than zero")
+	}
+
+	return ps[0].withRat(total.Quo(total, weightSum)).GetPayable(), nil
+}
+
+// pick returns the price for which better returns true compared to all others, the first one wins on ties
+func (ps Prices) pick(better func(candidate, current Price) bool) (Price, error) {
+	if err := ps.guard(); err != nil {
+		return NewZero(""), err
+	}
+	result := ps[0]
+	for _, price := range ps[1:] {
+		if better(price, result) {
+			result = price
+		}
+	}
+	return result.Clone(), nil
+}
+
+// guard returns an error if the list is empty or contains different currencies
+func (ps Prices) guard() error {
+	if len(ps) == 0 {
+		return ErrEmptyPriceList
+	}
+	for _, price := range ps[1:] {
+		if price.currency != ps[0].currency {
+			return ErrCurrencyMismatch
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrices_Sum(t *testing.T) {
+	sum, err := Prices{NewFromFloat(1.5, "EUR"), NewFromFloat(2.25, "EUR")}.Sum()
+	require.NoError(t, err)
+	assert.Equal(t, "3.75", sum.Amount().Text('f', -1))
+
+	_, err = Prices{}.Sum()
+	assert.Error(t, err)
+
+	_, err = Prices{NewFromFloat(1.5, "EUR"), NewFromFloat(2.25, "USD")}.Sum()
+	assert.Error(t, err)
+}
+
+func TestPrices_MinMax(t *testing.T) {
+	prices := Prices{NewFromFloat(2.5, "EUR"), NewFromFloat(-1, "EUR"), NewFromFloat(7, "EUR")}
+
+	lowest, err := prices.Min()
+	require.NoError(t, err)
+	assert.True(t, lowest.Equal(NewFromFloat(-1, "EUR")))
+
+	highest, err := prices.Max()
+	require.NoError(t, err)
+	assert.True(t, highest.Equal(NewFromFloat(7, "EUR")))
+
+	_, err = Prices{}.Min()
+	assert.Error(t, err)
+
+	_, err = Prices{}.Max()
+	assert.Error(t, err)
+
+	_, err = append(prices, NewFromFloat(1, "USD")).Max()
+	assert.Error(t, err)
+}
+
+func TestPrices_Average(t *testing.T) {
+	average, err := Prices{NewFromFloat(1, "EUR"), NewFromFloat(1, "EUR"), NewFromFloat(2, "EUR")}.Average()
+	require.NoError(t, err)
+	assert.Equal(t, "1.33", average.Amount().Text('f', 2))
+	assert.True(t, average.IsPayable())
+
+	_, err = Prices{}.Average()
+	assert.Error(t, err)
+
+	_, err = Prices{NewFromFloat(1, "EUR"), NewFromFloat(1, "USD")}.Average()
+	assert.Error(t, err)
+}
+
+func TestPrices_Median(t *testing.T) {
+	median, err := Prices{NewFromFloat(9, "EUR"), NewFromFloat(1, "EUR"), NewFromFloat(4.5, "EUR")}.Median()
+	require.NoError(t, err)
+	assert.Equal(t, "4.50", median.Amount().Text('f', 2))
+
+	unsorted := Prices{NewFromFloat(9, "EUR"), NewFromFloat(1, "EUR"), NewFromFloat(4.5, "EUR"), NewFromFloat(2.25, "EUR")}
+	median, err = unsorted.Median()
+	require.NoError(t, err)
+	assert.Equal(t, "3.38", median.Amount().Text('f', 2))
+	assert.True(t, unsorted[0].Equal(NewFromFloat(9, "EUR")), "given prices are not reordered")
+
+	_, err = Prices{}.Median()
+	assert.Error(t, err)
+
+	_, err = Prices{NewFromFloat(1, "EUR"), NewFromFloat(1, "USD")}.Median()
+	assert.Error(t, err)
+}
+
+func TestPrices_WeightedAverage(t *testing.T) {
+	prices := Prices{NewFromFloat(10, "EUR"), NewFromFloat(20, "EUR")}
+
+	average, err := prices.WeightedAverage([]int64{3, 1})
+	require.NoError(t, err)
+	assert.Equal(t, "12.50", average.Amount().Text('f', 2))
+
+	average, err = prices.WeightedAverage([]int64{0, 1})
+	require.NoError(t, err)
+	assert.Equal(t, "20.00", average.Amount().Text('f', 2))
+
+	_, err = prices.WeightedAverage([]int64{1})
+	assert.Error(t, err)
+
+	_, err = prices.WeightedAverage([]int64{1, -1})
+	assert.Error(t, err)
+
+	_, err = prices.WeightedAverage([]int64{0, 0})
+	assert.Error(t, err)
+
+	_, err = Prices{}.WeightedAverage(nil)
+	assert.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, Compare(NewFromFloat(1, "EUR"), NewFromFloat(2, "EUR")))
+	assert.Equal(t, 1, Compare(NewFromFloat(2, "EUR"), NewFromFloat(1, "EUR")))
+	assert.Equal(t, 0, Compare(NewFromFloat(2, "EUR"), NewFromFloat(2, "EUR")))
+	assert.Equal(t, -1, Compare(NewFromFloat(100, "EUR"), NewFromFloat(1, "USD")), "currency is compared first")
+	assert.Equal(t, 1, Compare(NewFromFloat(1, "USD"), NewFromFloat(100, "EUR")))
+}
+
+func TestPrices_Sort(t *testing.T) {
+	prices := Prices{
+		NewFromFloat(3, "USD"),
+		NewFromFloat(2, "EUR"),
+		NewFromFloat(1, "USD"),
+		NewFromFloat(-1, "EUR"),
+	}
+
+	prices.Sort()
+	assert.Equal(t, []string{"-1 EUR", "2 EUR", "1 USD", "3 USD"}, pricesToStrings(prices))
+
+	prices.SortDesc()
+	assert.Equal(t, []string{"3 USD", "1 USD", "2 EUR", "-1 EUR"}, pricesToStrings(prices))
+}
+
+func pricesToStrings(prices Prices) []string {
+	result := make([]string, 0, len(prices))
+	for _, price := range prices {
+		result = append(result, price.Amount().Text('f', -1)+" "+price.Currency())
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+)
+
+type (
+	// ProtoMoney holds the fields of the google.type.Money protobuf message.
+	// It avoids a dependency on the generated protobuf package, convert it like this:
+	//
+	//	pm, err := p.ToProtoMoney()
+	//	m := &money.Money{CurrencyCode: pm.CurrencyCode, Units: pm.Units, Nanos: pm.Nanos}
+	ProtoMoney struct {
+		// CurrencyCode is the ISO 4217 currency code
+		CurrencyCode string
+		// Units is the whole units of the amount
+		Units int64
+		// Nanos is the number of nano (10^-9) units of the amount, it has the same sign as Units
+		Nanos int32
+	}
+
+	// ProtoMoneyMessage is implemented by the generated google.type.Money message (*money.Money) and ProtoMoney
+	ProtoMoneyMessage interface {
+		GetCurrencyCode() string
+		GetUnits() int64
+		GetNanos() int32
+	}
+)
+
+const nanosPerUnit = 1000000000
+
+// ToProtoMoney converts the price to the google.type.Money representation.
+// Digits beyond nanos are rounded half up, an error is returned if the units do not fit into an int64
+func (p Price) ToProtoMoney() (ProtoMoney, error) {
+	if p.amount.IsInf() {
+		return ProtoMoney{}, newDetailedError(ErrNotFinite, "infinite amount cannot be converted to money")
+	}
+
+	amount, _ := p.amount.Rat(nil)
+	totalNanos := roundRat(amount.Mul(amount, big.NewRat(nanosPerUnit, 1)), RoundingModeHalfUp)
+	units, nanos := new(big.Int).QuoRem(totalNanos, big.NewInt(nanosPerUnit), new(big.Int))
+	if !units.IsInt64() {
+		return ProtoMoney{}, errors.New("amount exceeds the range of money units")
+	}
+
+	return ProtoMoney{
+		CurrencyCode: p.currency,
+		Units:        units.Int64(),
+		Nanos:        int32(nanos.Int64()),
+	}, nil
+}
+
+// FromProtoMoney creates a price from a google.type.Money message, e.g. a *money.Money
+func FromProtoMoney(m ProtoMoneyMessage) (Price, error) {
+	units := m.GetUnits()
+	nanos := m.GetNanos()
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return NewZero(m.GetCurrencyCode()), errors.New("nanos must be between -999999999 and +999999999")
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return NewZero(m.GetCurrencyCode()), errors.New("units and nanos must have the same sign")
+	}
+
+	amount := new(big.Rat).SetFrac64(int64(nanos), nanosPerUnit)
+	amount.Add(amount, new(big.Rat).SetInt64(units))
+	return NewFromBigFloat(*new(big.Float).SetPrec(128).SetRat(amount), m.GetCurrencyCode()), nil
+}
+
+// GetCurrencyCode returns the currency code
+func (m ProtoMoney) GetCurrencyCode() string {
+	return m.CurrencyCode
+}
+
+// GetUnits returns the whole units
+func (m ProtoMoney) GetUnits() int64 {
+	return m.Units
+}
+
+// GetNanos returns the nano units
+func (m ProtoMoney) GetNanos() int32 {
+	return m.Nanos
+}
@@ -0,0 +1,60 @@
+package price
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_ToProtoMoney(t *testing.T) {
+	tests := []struct {
+		price    Price
+		expected ProtoMoney
+	}{
+		{price: NewFromInt(1234, 100, "EUR"), expected: ProtoMoney{CurrencyCode: "EUR", Units: 12, Nanos: 340000000}},
+		{price: NewFromInt(-175, 100, "USD"), expected: ProtoMoney{CurrencyCode: "USD", Units: -1, Nanos: -750000000}},
+		{price: NewFromInt(-25, 100, "USD"), expected: ProtoMoney{CurrencyCode: "USD", Units: 0, Nanos: -250000000}},
+		{price: NewFromFloat(0.0000000005, "EUR"), expected: ProtoMoney{CurrencyCode: "EUR", Units: 0, Nanos: 1}},
+		{price: NewZero("EUR"), expected: ProtoMoney{CurrencyCode: "EUR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.price.String(), func(t *testing.T) {
+			money, err := tt.price.ToProtoMoney()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, money)
+		})
+	}
+
+	_, err := NewFromFloat(math.MaxInt64, "EUR").Multiply(2).ToProtoMoney()
+	assert.Error(t, err)
+}
+
+func TestFromProtoMoney(t *testing.T) {
+	p, err := FromProtoMoney(ProtoMoney{CurrencyCode: "EUR", Units: 12, Nanos: 340000000})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", p.Currency())
+	assert.Equal(t, "12.340000000", p.Amount().Text('f', 9))
+
+	p, err = FromProtoMoney(ProtoMoney{CurrencyCode: "USD", Units: math.MaxInt64, Nanos: 999999999})
+	require.NoError(t, err)
+	assert.Equal(t, "9223372036854775807.999999999", p.Amount().Text('f', 9))
+
+	_, err = FromProtoMoney(ProtoMoney{CurrencyCode: "EUR", Units: 1, Nanos: -1})
+	assert.Error(t, err)
+
+	_, err = FromProtoMoney(ProtoMoney{CurrencyCode: "EUR", Nanos: 1000000000})
+	assert.Error(t, err)
+}
+
+func TestProtoMoney_RoundTrip(t *testing.T) {
+	original := NewFromInt(-98765, 1000, "BHD")
+	money, err := original.ToProtoMoney()
+	require.NoError(t, err)
+
+	p, err := FromProtoMoney(money)
+	require.NoError(t, err)
+	assert.True(t, p.LikelyEqual(original))
+}
@@ -0,0 +1,88 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+)
+
+// BillingInterval is the period a RecurringPrice is charged for
+type BillingInterval string
+
+const (
+	// BillingWeekly is charged 52 times a year
+	BillingWeekly BillingInterval = "weekly"
+	// BillingMonthly is charged 12 times a year
+	BillingMonthly BillingInterval = "monthly"
+	// BillingQuarterly is charged 4 times a year
+	BillingQuarterly BillingInterval = "quarterly"
+	// BillingYearly is charged once a year
+	BillingYearly BillingInterval = "yearly"
+)
+
+// billingPeriodsPerYear is used to convert between intervals
+var billingPeriodsPerYear = map[BillingInterval]int64{
+	BillingWeekly:    52,
+	BillingMonthly:   12,
+	BillingQuarterly: 4,
+	BillingYearly:    1,
+}
+
+// RecurringPrice is a price charged every interval, e.g. 9.99 EUR monthly for a subscription
+type RecurringPrice struct {
+	Price    Price           `json:"price"`
+	Interval BillingInterval `json:"interval"`
+}
+
+// NewRecurringPrice returns a recurring price, an error is returned for unknown intervals
+func NewRecurringPrice(price Price, interval BillingInterval) (RecurringPrice, error) {
+	if err := interval.Validate(); err != nil {
+		return RecurringPrice{}, err
+	}
+	return RecurringPrice{Price: price, Interval: interval}, nil
+}
+
+// Validate returns an error for unknown intervals
+func (i BillingInterval) Validate() error {
+	if _, ok := billingPeriodsPerYear[i]; !ok {
+		return errors.New("unknown billing interval " + string(i))
+	}
+	return nil
+}
+
+// To returns the exact equivalent price for another interval, e.g. 120.00 yearly is 10.00 monthly.
+// The result is not rounded, use GetPayable on its Price for charging
+func (r RecurringPrice) To(interval BillingInterval) (RecurringPrice, error) {
+	if err := r.Interval.Validate(); err != nil {
+		return RecurringPrice{}, err
+	}
+	if err := interval.Validate(); err != nil {
+		return RecurringPrice{}, err
+	}
+	factor := big.NewRat(billingPeriodsPerYear[r.Interval], billingPeriodsPerYear[interval])
+	return RecurringPrice{Price: r.Price.mulRat(factor), Interval: interval}, nil
+}
+
+// Annualized returns the price charged within a year
+func (r RecurringPrice) Annualized() (Price, error) {
+	yearly, err := r.To(BillingYearly)
+	if err != nil {
+		return Price{}, err
+	}
+	return yearly.Price, nil
+}
+
+// UnmarshalJSON implements encode Unmarshaler and rejects unknown intervals
+func (r *RecurringPrice) UnmarshalJSON(data []byte) error {
+	// recurringPrice avoids the recursion into UnmarshalJSON
+	type recurringPrice RecurringPrice
+	var decoded recurringPrice
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if err := decoded.Interval.Validate(); err != nil {
+		return err
+	}
+	*r = RecurringPrice(decoded)
+	return nil
+}
@@ -0,0 +1,57 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringPrice_To(t *testing.T) {
+	monthly, err := NewRecurringPrice(NewFromInt(999, 100, "EUR"), BillingMonthly)
+	require.NoError(t, err)
+
+	tests := []struct {
+		interval BillingInterval
+		want     string
+	}{
+		{interval: BillingYearly, want: "119.88"},
+		{interval: BillingQuarterly, want: "29.97"},
+		{interval: BillingWeekly, want: "2.31"},
+		{interval: BillingMonthly, want: "9.99"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.interval), func(t *testing.T) {
+			converted, err := monthly.To(tt.interval)
+			require.NoError(t, err)
+			assert.Equal(t, tt.interval, converted.Interval)
+			assert.Equal(t, tt.want, converted.Price.GetPayable().Amount().Text('f', 2))
+		})
+	}
+
+	annual, err := monthly.Annualized()
+	require.NoError(t, err)
+	assert.Equal(t, "119.88", annual.GetPayable().Amount().Text('f', 2))
+
+	_, err = monthly.To("daily")
+	assert.Error(t, err)
+
+	_, err = NewRecurringPrice(NewFromInt(1, 1, "EUR"), "daily")
+	assert.Error(t, err)
+}
+
+func TestRecurringPrice_JSON(t *testing.T) {
+	yearly, _ := NewRecurringPrice(NewFromInt(120, 1, "EUR"), BillingYearly)
+
+	data, err := json.Marshal(yearly)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"120","currency":"EUR"},"interval":"yearly"}`, string(data))
+
+	var decoded RecurringPrice
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, BillingYearly, decoded.Interval)
+	assert.True(t, decoded.Price.Equal(yearly.Price))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"price":{"amount":"1","currency":"EUR"},"interval":"daily"}`), &decoded))
+}
@@ -0,0 +1,122 @@
+package price
+
+import (
+	"math/big"
+	"strings"
+)
+
+// RoundingMode defines how an amount is rounded, see GetPayableByRoundingMode
+type RoundingMode string
+
+// roundingModes lists all supported rounding modes
+var roundingModes = []RoundingMode{
+	RoundingModeFloor,
+	RoundingModeCeil,
+	RoundingModeHalfUp,
+	RoundingModeHalfDown,
+	RoundingModeHalfEven,
+}
+
+// RoundingModes returns all supported rounding modes
+func RoundingModes() []RoundingMode {
+	return append([]RoundingMode(nil), roundingModes...)
+}
+
+// ParseRoundingMode parses a rounding mode case-insensitive, separators are ignored so that
+// "halfup", "HALF_UP" and "half-up" all result in RoundingModeHalfUp
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	normalized = strings.NewReplacer("_", "", "-", "", " ", "").Replace(normalized)
+
+	mode := RoundingMode(normalized)
+	if err := mode.Validate(); err != nil {
+		return "", newDetailedError(ErrUnknownRoundingMode, "unknown rounding mode "+s)
+	}
+	return mode, nil
+}
+
+// IsValid returns true if the rounding mode is supported
+func (m RoundingMode) IsValid() bool {
+	for _, mode := range roundingModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the rounding mode is not supported
+func (m RoundingMode) Validate() error {
+	if !m.IsValid() {
+		return newDetailedError(ErrUnknownRoundingMode, "unknown rounding mode "+string(m))
+	}
+	return nil
+}
+
+// String returns the rounding mode name
+func (m RoundingMode) String() string {
+	return string(m)
+}
+
+// MarshalText implements encoding.TextMarshaler, the empty mode of unset fields is marshalled as empty text
+// and unknown modes cannot be marshalled
+func (m RoundingMode) MarshalText() ([]byte, error) {
+	if m == "" {
+		return []byte{}, nil
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(m), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, see ParseRoundingMode. Empty text results in the empty mode
+func (m *RoundingMode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = ""
+		return nil
+	}
+	mode, err := ParseRoundingMode(string(text))
+	if err != nil {
+		return err
+	}
+	*m = mode
+	return nil
+}
+
+// roundRat rounds the rational to an integer using the given rounding mode, an unknown mode truncates
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	num := r.Num()
+	den := r.Denom()
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	sign := int64(num.Sign())
+	// compare the discarded fraction with one half
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmpHalf := half.Cmp(den)
+
+	roundAway := false
+	switch mode {
+	case RoundingModeFloor:
+		roundAway = sign < 0
+	case RoundingModeCeil:
+		roundAway = sign > 0
+	case RoundingModeHalfUp:
+		roundAway = cmpHalf >= 0
+	case RoundingModeHalfDown:
+		roundAway = cmpHalf > 0
+	case RoundingModeHalfEven:
+		roundAway = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+	default:
+		// truncate
+	}
+
+	if roundAway {
+		quo.Add(quo, big.NewInt(sign))
+	}
+	return quo
+}
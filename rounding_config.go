@@ -0,0 +1,95 @@
+package price
+
+import (
+	"strings"
+	"sync"
+)
+
+type (
+	// RoundingConfig defines how payable prices of a currency are rounded
+	RoundingConfig struct {
+		// Mode used for rounding
+		Mode RoundingMode
+		// Precision of the smallest payable unit, e.g. 100 for cents
+		Precision int
+	}
+
+	// RoundingConfigProvider returns the rounding configuration of a currency.
+	// If the second return value is false the DefaultRoundingConfigProvider is used
+	RoundingConfigProvider interface {
+		RoundingConfig(currency string) (RoundingConfig, bool)
+	}
+
+	// RoundingConfigProviderFunc is a function implementing RoundingConfigProvider
+	RoundingConfigProviderFunc func(currency string) (RoundingConfig, bool)
+
+	// StaticRoundingConfig is a RoundingConfigProvider with fixed configurations by currency (case-insensitive)
+	StaticRoundingConfig map[string]RoundingConfig
+
+	defaultRoundingConfigProvider struct{}
+)
+
+// DefaultRoundingConfigProvider rounds loyalty currencies ("miles", "points") down to whole numbers
+// and all other currencies half up to the minor units registered for the currency (see LookupCurrency), defaulting to 2
+var DefaultRoundingConfigProvider RoundingConfigProvider = defaultRoundingConfigProvider{}
+
+var (
+	roundingConfigProviderMu sync.RWMutex
+	roundingConfigProvider   RoundingConfigProvider = DefaultRoundingConfigProvider
+)
+
+// SetRoundingConfigProvider registers the provider used by GetPayable and all other payable calculations.
+// Pass nil to restore the DefaultRoundingConfigProvider
+func SetRoundingConfigProvider(provider RoundingConfigProvider) {
+	roundingConfigProviderMu.Lock()
+	defer roundingConfigProviderMu.Unlock()
+	if provider == nil {
+		provider = DefaultRoundingConfigProvider
+	}
+	roundingConfigProvider = provider
+}
+
+func currentRoundingConfigProvider() RoundingConfigProvider {
+	roundingConfigProviderMu.RLock()
+	defer roundingConfigProviderMu.RUnlock()
+	return roundingConfigProvider
+}
+
+// RoundingConfig calls f(currency)
+func (f RoundingConfigProviderFunc) RoundingConfig(currency string) (RoundingConfig, bool) {
+	return f(currency)
+}
+
+// RoundingConfig returns the configuration of the currency
+func (s StaticRoundingConfig) RoundingConfig(currency string) (RoundingConfig, bool) {
+	if config, ok := s[currency]; ok {
+		return config, true
+	}
+	for key, config := range s {
+		if strings.EqualFold(key, currency) {
+			return config, true
+		}
+	}
+	return RoundingConfig{}, false
+}
+
+func (defaultRoundingConfigProvider) RoundingConfig(currency string) (RoundingConfig, bool) {
+	if strings.ToLower(currency) == "miles" || strings.ToLower(currency) == "points" {
+		return RoundingConfig{Mode: RoundingModeFloor, Precision: 1}, true
+	}
+	if info, ok := LookupCurrency(currency); ok {
+		return RoundingConfig{Mode: RoundingModeHalfUp, Precision: info.Precision()}, true
+	}
+	return RoundingConfig{Mode: RoundingModeHalfUp, Precision: 100}, true
+}
+
+// roundingConfigFrom returns the configuration of the provider, falling back to the default one
+func roundingConfigFrom(provider RoundingConfigProvider, currency string) RoundingConfig {
+	if provider != nil {
+		if config, ok := provider.RoundingConfig(currency); ok && config.Precision > 0 {
+			return config
+		}
+	}
+	config, _ := DefaultRoundingConfigProvider.RoundingConfig(currency)
+	return config
+}
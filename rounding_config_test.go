@@ -0,0 +1,49 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRoundingConfigProvider(t *testing.T) {
+	config, ok := DefaultRoundingConfigProvider.RoundingConfig("Points")
+	assert.True(t, ok)
+	assert.Equal(t, RoundingConfig{Mode: RoundingModeFloor, Precision: 1}, config)
+
+	config, _ = DefaultRoundingConfigProvider.RoundingConfig("JPY")
+	assert.Equal(t, RoundingConfig{Mode: RoundingModeHalfUp, Precision: 1}, config)
+
+	config, _ = DefaultRoundingConfigProvider.RoundingConfig("unknown")
+	assert.Equal(t, RoundingConfig{Mode: RoundingModeHalfUp, Precision: 100}, config)
+}
+
+func TestSetRoundingConfigProvider(t *testing.T) {
+	SetRoundingConfigProvider(StaticRoundingConfig{
+		"eur":     {Mode: RoundingModeFloor, Precision: 10},
+		"credits": {Mode: RoundingModeCeil, Precision: 1},
+	})
+	defer SetRoundingConfigProvider(nil)
+
+	assert.Equal(t, "12.3", NewFromFloat(12.39, "EUR").GetPayable().Amount().Text('f', 1))
+	assert.Equal(t, "13", NewFromFloat(12.01, "credits").GetPayable().Amount().Text('f', 0))
+	// not configured currencies fall back to the default
+	assert.Equal(t, "12.40", NewFromFloat(12.395, "USD").GetPayable().Amount().Text('f', 2))
+	assert.Equal(t, "12", NewFromFloat(12.9, "miles").GetPayable().Amount().Text('f', 0))
+
+	SetRoundingConfigProvider(nil)
+	assert.Equal(t, "12.39", NewFromFloat(12.39, "EUR").GetPayable().Amount().Text('f', 2))
+}
+
+func TestPrice_GetPayableWith(t *testing.T) {
+	tenant := RoundingConfigProviderFunc(func(currency string) (RoundingConfig, bool) {
+		if currency == "USD" {
+			return RoundingConfig{Mode: RoundingModeHalfEven, Precision: 100}, true
+		}
+		return RoundingConfig{}, false
+	})
+
+	assert.Equal(t, "0.12", NewFromInt(125, 1000, "USD").GetPayableWith(tenant).Amount().Text('f', 2))
+	assert.Equal(t, "0.13", NewFromInt(125, 1000, "EUR").GetPayableWith(tenant).Amount().Text('f', 2))
+	assert.Equal(t, "0.13", NewFromInt(125, 1000, "USD").GetPayable().Amount().Text('f', 2))
+}
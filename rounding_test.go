@@ -0,0 +1,102 @@
+package price
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoundingMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected RoundingMode
+		wantErr  bool
+	}{
+		{input: "floor", expected: RoundingModeFloor},
+		{input: "CEIL", expected: RoundingModeCeil},
+		{input: "half_up", expected: RoundingModeHalfUp},
+		{input: "Half-Down", expected: RoundingModeHalfDown},
+		{input: " HALF EVEN ", expected: RoundingModeHalfEven},
+		{input: "halfupp", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, err := ParseRoundingMode(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+}
+
+func TestRoundingMode_Validate(t *testing.T) {
+	modes := RoundingModes()
+	for _, mode := range modes {
+		assert.NoError(t, mode.Validate())
+	}
+	assert.Error(t, RoundingMode("round").Validate())
+	assert.False(t, RoundingMode("").IsValid())
+
+	modes[0] = "round"
+	assert.False(t, RoundingMode("round").IsValid())
+	assert.Equal(t, RoundingModeFloor, RoundingModes()[0])
+}
+
+func TestRoundingMode_JSON(t *testing.T) {
+	type config struct {
+		Mode RoundingMode `json:"mode"`
+	}
+
+	data, err := json.Marshal(config{Mode: RoundingModeHalfEven})
+	require.NoError(t, err)
+	assert.Equal(t, `{"mode":"halfeven"}`, string(data))
+
+	var c config
+	require.NoError(t, json.Unmarshal([]byte(`{"mode":"HALF_UP"}`), &c))
+	assert.Equal(t, RoundingModeHalfUp, c.Mode)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"mode":"typo"}`), &c))
+
+	_, err = json.Marshal(config{Mode: "typo"})
+	assert.Error(t, err)
+
+	data, err = json.Marshal(config{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"mode":""}`, string(data))
+	c = config{Mode: RoundingModeFloor}
+	require.NoError(t, json.Unmarshal(data, &c))
+	assert.Equal(t, RoundingMode(""), c.Mode)
+}
+
+func TestRoundRat(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{num: 5, den: 2, mode: RoundingModeHalfUp, expected: 3},
+		{num: -5, den: 2, mode: RoundingModeHalfUp, expected: -3},
+		{num: 5, den: 2, mode: RoundingModeHalfDown, expected: 2},
+		{num: 5, den: 2, mode: RoundingModeHalfEven, expected: 2},
+		{num: 7, den: 2, mode: RoundingModeHalfEven, expected: 4},
+		{num: -7, den: 2, mode: RoundingModeHalfEven, expected: -4},
+		{num: 11, den: 10, mode: RoundingModeCeil, expected: 2},
+		{num: -11, den: 10, mode: RoundingModeCeil, expected: -1},
+		{num: 11, den: 10, mode: RoundingModeFloor, expected: 1},
+		{num: -11, den: 10, mode: RoundingModeFloor, expected: -2},
+		{num: 19, den: 10, mode: "unknown", expected: 1},
+	}
+
+	for _, tt := range tests {
+		got := roundRat(big.NewRat(tt.num, tt.den), tt.mode)
+		assert.Equal(t, tt.expected, got.Int64(), "%d/%d %s", tt.num, tt.den, tt.mode)
+	}
+}
@@ -0,0 +1,174 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+type (
+	// RemainderStrategy defines which parts of a split receive the remaining cents
+	RemainderStrategy string
+
+	// SplitOption configures a split, see SplitInPayables, SplitByRatios and SplitByPercentages
+	SplitOption func(*splitOptions)
+
+	splitOptions struct {
+		remainder RemainderStrategy
+	}
+)
+
+const (
+	// RemainderFirst gives the remaining cents to the first parts (default)
+	RemainderFirst RemainderStrategy = "first"
+	// RemainderLast gives the remaining cents to the last parts, e.g. to the last installment
+	RemainderLast RemainderStrategy = "last"
+	// RemainderLargestShare gives the remaining cents to the parts with the largest share, ties are resolved in order
+	RemainderLargestShare RemainderStrategy = "largest-share"
+	// RemainderRoundRobin spreads the remaining cents evenly across all parts starting with the first
+	RemainderRoundRobin RemainderStrategy = "round-robin"
+)
+
+// WithRemainder sets the strategy used to distribute the remaining cents of a split
+func WithRemainder(strategy RemainderStrategy) SplitOption {
+	return func(o *splitOptions) {
+		o.remainder = strategy
+	}
+}
+
+// SplitByRatios returns payable prices distributed proportionally to the given ratios, e.g. []int64{1, 2} splits 10.00 into 3.34 and 6.66.
+// The parts always sum up exactly to the payable price, by default remaining cents are distributed to the first parts with a non zero ratio.
+func (p Price) SplitByRatios(ratios []int64, opts ...SplitOption) ([]Price, error) {
+	weights := make([]*big.Rat, len(ratios))
+	for i, ratio := range ratios {
+		if ratio < 0 {
+			return nil, errors.New("ratios must not be negative")
+		}
+		weights[i] = new(big.Rat).SetInt64(ratio)
+	}
+	return p.allocate(weights, opts...)
+}
+
+// SplitByPercentages returns payable prices for the given percentage shares, e.g. []float64{70, 20, 10}.
+// The percentages must sum up to 100. The parts always sum up exactly to the payable price,
+// by default remaining cents are distributed to the first parts with a non zero share.
+func (p Price) SplitByPercentages(percents []float64, opts ...SplitOption) ([]Price, error) {
+	weights := make([]*big.Rat, len(percents))
+	sum := new(big.Rat)
+	for i, percent := range percents {
+		share, err := NewPercentFromFloatChecked(percent)
+		if err != nil {
+			return nil, err
+		}
+		weights[i] = share.Rat()
+		if weights[i].Sign() < 0 {
+			return nil, errors.New("percentages must not be negative")
+		}
+		sum.Add(sum, weights[i])
+	}
+	if sum.Cmp(big.NewRat(100, 1)) != 0 {
+		return nil, errors.New("percentages must sum up to 100")
+	}
+	return p.allocate(weights, opts...)
+}
+
+// allocate distributes the payable amount by the given weights, see SplitByRatios
+func (p Price) allocate(weights []*big.Rat, opts ...SplitOption) ([]Price, error) {
+	if len(weights) == 0 {
+		return nil, ErrInvalidSplitCount
+	}
+	options := splitOptions{remainder: RemainderFirst}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if err := options.remainder.validate(); err != nil {
+		return nil, err
+	}
+	sum := new(big.Rat)
+	for _, weight := range weights {
+		sum.Add(sum, weight)
+	}
+	if sum.Sign() <= 0 {
+		return nil, errors.New("sum of the split weights must be higher than zero")
+	}
+	if p.amount.IsInf() {
+		return nil, newDetailedError(ErrNotFinite, "cannot split infinite amounts")
+	}
+
+	units, precision := p.payableUnits()
+	// we have to invert negative numbers, otherwise the distribution of the remainder is not correct
+	negative := units.Sign() < 0
+	units.Abs(units)
+
+	shares := make([]*big.Int, len(weights))
+	distributed := new(big.Int)
+	for i, weight := range weights {
+		share := new(big.Rat).Mul(new(big.Rat).SetInt(units), weight)
+		share.Quo(share, sum)
+		shares[i] = new(big.Int).Quo(share.Num(), share.Denom())
+		distributed.Add(distributed, shares[i])
+	}
+
+	// the remainder is always lower than the count of parts with a non zero weight
+	remainder := int(new(big.Int).Sub(units, distributed).Int64())
+	for _, i := range remainderReceivers(weights, options.remainder, remainder) {
+		shares[i].Add(shares[i], big.NewInt(1))
+	}
+
+	prices := make([]Price, len(shares))
+	for i, share := range shares {
+		// invert prices again to keep negative values
+		if negative {
+			share.Neg(share)
+		}
+		prices[i] = p.withRat(new(big.Rat).SetFrac(share, big.NewInt(int64(precision))))
+	}
+	return prices, nil
+}
+
+// validate returns an error for unknown strategies
+func (r RemainderStrategy) validate() error {
+	switch r {
+	case RemainderFirst, RemainderLast, RemainderLargestShare, RemainderRoundRobin:
+		return nil
+	}
+	return errors.New("unknown remainder strategy " + string(r))
+}
+
+// remainderReceivers returns the indexes of the parts receiving one of the remaining cents,
+// only parts with a non zero weight are considered
+func remainderReceivers(weights []*big.Rat, strategy RemainderStrategy, remainder int) []int {
+	var eligible []int
+	for i, weight := range weights {
+		if weight.Sign() != 0 {
+			eligible = append(eligible, i)
+		}
+	}
+
+	switch strategy {
+	case RemainderLast:
+		return eligible[len(eligible)-remainder:]
+	case RemainderLargestShare:
+		sort.SliceStable(eligible, func(i, j int) bool {
+			return weights[eligible[i]].Cmp(weights[eligible[j]]) > 0
+		})
+	case RemainderRoundRobin:
+		receivers := make([]int, remainder)
+		for k := range receivers {
+			receivers[k] = eligible[k*len(eligible)/remainder]
+		}
+		return receivers
+	}
+	return eligible[:remainder]
+}
+
+// payableUnits returns the payable amount in units of the payable precision, e.g. 1234 and 100 for 12.34 EUR.
+// The amount must be finite
+func (p Price) payableUnits() (*big.Int, int) {
+	mode, precision := p.payableRoundingPrecision()
+	payable := p.GetPayableByRoundingMode(mode, precision)
+	amount, _ := payable.amount.Rat(nil)
+	amount.Mul(amount, new(big.Rat).SetInt64(int64(precision)))
+	// the payable amount is a multiple of the precision, rounding only removes the binary representation error
+	return roundRat(amount, RoundingModeHalfUp), precision
+}
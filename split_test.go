@@ -0,0 +1,134 @@
+package price
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func amountsOf(prices []Price) []string {
+	amounts := make([]string, len(prices))
+	for i, p := range prices {
+		amounts[i] = p.Amount().Text('f', 2)
+	}
+	return amounts
+}
+
+func TestPrice_SplitByRatios(t *testing.T) {
+	tests := []struct {
+		name     string
+		price    Price
+		ratios   []int64
+		expected []string
+	}{
+		{name: "fowler example", price: NewFromFloat(0.05, "EUR"), ratios: []int64{3, 7}, expected: []string{"0.02", "0.03"}},
+		{name: "one third", price: NewFromInt(10, 1, "EUR"), ratios: []int64{1, 2}, expected: []string{"3.34", "6.66"}},
+		{name: "equal", price: NewFromInt(100, 100, "EUR"), ratios: []int64{1, 1, 1}, expected: []string{"0.34", "0.33", "0.33"}},
+		{name: "zero ratio gets nothing", price: NewFromInt(100, 100, "EUR"), ratios: []int64{0, 1, 1, 1}, expected: []string{"0.00", "0.34", "0.33", "0.33"}},
+		{name: "payable amount", price: NewFromFloat(12.456, "EUR"), ratios: []int64{1, 1}, expected: []string{"6.23", "6.23"}},
+		{name: "negative", price: NewFromFloat(-10, "EUR"), ratios: []int64{1, 2}, expected: []string{"-3.34", "-6.66"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := tt.price.SplitByRatios(tt.ratios)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, amountsOf(parts))
+
+			sum, err := SumAll(parts...)
+			require.NoError(t, err)
+			assert.True(t, sum.GetPayable().Equal(tt.price.GetPayable()))
+			assert.Equal(t, tt.price.Currency(), parts[0].Currency())
+		})
+	}
+
+	_, err := NewFromInt(10, 1, "EUR").SplitByRatios(nil)
+	assert.Error(t, err)
+	_, err = NewFromInt(10, 1, "EUR").SplitByRatios([]int64{0, 0})
+	assert.Error(t, err)
+	_, err = NewFromInt(10, 1, "EUR").SplitByRatios([]int64{1, -1})
+	assert.Error(t, err)
+}
+
+func TestPrice_SplitByPercentages(t *testing.T) {
+	parts, err := NewFromInt(1001, 100, "EUR").SplitByPercentages([]float64{70, 20, 10})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7.01", "2.00", "1.00"}, amountsOf(parts))
+
+	parts, err = NewFromInt(100, 100, "EUR").SplitByPercentages([]float64{33.33, 33.33, 33.34})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.34", "0.33", "0.33"}, amountsOf(parts))
+
+	_, err = NewFromInt(10, 1, "EUR").SplitByPercentages([]float64{70, 20})
+	assert.Error(t, err)
+	_, err = NewFromInt(10, 1, "EUR").SplitByPercentages([]float64{110, -10})
+	assert.Error(t, err)
+	_, err = NewFromInt(10, 1, "EUR").SplitByPercentages([]float64{100, math.NaN()})
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestPrice_SplitInPayables_RemainderStrategy(t *testing.T) {
+	price := NewFromFloat(12.456, "EUR")
+
+	tests := []struct {
+		strategy RemainderStrategy
+		expected []string
+	}{
+		{strategy: RemainderFirst, expected: []string{"2.08", "2.08", "2.08", "2.08", "2.07", "2.07"}},
+		{strategy: RemainderLast, expected: []string{"2.07", "2.07", "2.08", "2.08", "2.08", "2.08"}},
+		{strategy: RemainderLargestShare, expected: []string{"2.08", "2.08", "2.08", "2.08", "2.07", "2.07"}},
+		{strategy: RemainderRoundRobin, expected: []string{"2.08", "2.08", "2.07", "2.08", "2.08", "2.07"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			parts, err := price.SplitInPayables(6, WithRemainder(tt.strategy))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, amountsOf(parts))
+		})
+	}
+
+	parts, err := NewFromInt(100, 100, "EUR").SplitInPayables(6, WithRemainder(RemainderRoundRobin))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.17", "0.17", "0.16", "0.17", "0.17", "0.16"}, amountsOf(parts))
+
+	parts, err = NewFromInt(2, 100, "EUR").SplitInPayables(6, WithRemainder(RemainderRoundRobin))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.01", "0.00", "0.00", "0.01", "0.00", "0.00"}, amountsOf(parts))
+
+	_, err = price.SplitInPayables(6, WithRemainder("middle"))
+	assert.Error(t, err)
+}
+
+func TestPrice_SplitByRatios_RemainderStrategy(t *testing.T) {
+	price := NewFromInt(100, 100, "EUR")
+
+	parts, err := price.SplitByRatios([]int64{1, 3, 1, 1}, WithRemainder(RemainderLargestShare))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.17", "0.51", "0.16", "0.16"}, amountsOf(parts))
+
+	parts, err = price.SplitByRatios([]int64{1, 1, 1, 0}, WithRemainder(RemainderLast))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.33", "0.33", "0.34", "0.00"}, amountsOf(parts))
+}
+
+func TestPrice_SplitInfinite(t *testing.T) {
+	inf := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+
+	_, err := inf.SplitInPayables(3)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = inf.SplitByRatios([]int64{1, 2})
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = inf.SplitByPercentages([]float64{70, 30})
+	assert.ErrorIs(t, err, ErrNotFinite)
+
+	quotient, remainder := inf.DividedWithRemainder(3)
+	assert.True(t, quotient.Amount().IsInf())
+	assert.True(t, remainder.IsZero())
+	quotient, remainder = inf.DividedWithRemainder(0)
+	assert.True(t, quotient.IsZero())
+	assert.True(t, remainder.Equal(inf))
+}
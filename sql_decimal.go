@@ -0,0 +1,73 @@
+package price
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+type (
+	// SQLDecimal stores the amount of a price as plain decimal string, e.g. "12.34" for NUMERIC columns.
+	// The currency is not stored, keep it in a separate column:
+	//
+	//	db.Exec("INSERT INTO orders (total, currency) VALUES ($1, $2)", price.SQLDecimal(p), p.Currency())
+	//
+	// Scan keeps the currency of the receiver, so set it before scanning
+	SQLDecimal Price
+
+	// SQLMinorUnits stores the payable amount of a price as integer in minor units, e.g. 1234 for 12.34 EUR,
+	// see Price.MinorUnits. Like SQLDecimal the currency is not stored
+	SQLMinorUnits Price
+)
+
+// Price returns the price
+func (d SQLDecimal) Price() Price {
+	return Price(d)
+}
+
+// Value implements driver.Valuer, the amount is stored as decimal string
+func (d SQLDecimal) Value() (driver.Value, error) {
+	if d.amount.IsInf() {
+		return nil, ErrNotFinite
+	}
+	return Price(d).canonicalAmount(), nil
+}
+
+// Scan implements sql.Scanner for decimal strings and numbers, see Price.Scan
+func (d *SQLDecimal) Scan(value interface{}) error {
+	return (*Price)(d).Scan(value)
+}
+
+// Price returns the price
+func (m SQLMinorUnits) Price() Price {
+	return Price(m)
+}
+
+// Value implements driver.Valuer, the amount is stored as int64 in minor units
+func (m SQLMinorUnits) Value() (driver.Value, error) {
+	return Price(m).MinorUnits()
+}
+
+// Scan implements sql.Scanner for integers in minor units, NULL sets a zero amount
+func (m *SQLMinorUnits) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		s = "0"
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return errors.New("unsupported type for minor units")
+	}
+	units, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return errors.New("invalid minor units " + s)
+	}
+	*m = SQLMinorUnits(NewFromMinorUnits(units, m.currency))
+	return nil
+}
@@ -0,0 +1,51 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLDecimal(t *testing.T) {
+	value, err := SQLDecimal(NewFromInt(1234, 100, "EUR")).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "12.34", value)
+
+	_, err = SQLDecimal(NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")).Value()
+	assert.ErrorIs(t, err, ErrNotFinite)
+
+	scanned := SQLDecimal(NewZero("EUR"))
+	require.NoError(t, scanned.Scan([]byte("12.34")))
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(scanned.Price()))
+	assert.Equal(t, "EUR", scanned.Price().Currency())
+}
+
+func TestSQLMinorUnits(t *testing.T) {
+	value, err := SQLMinorUnits(NewFromFloat(12.345, "EUR")).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1235), value)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  Price
+	}{
+		{name: "int", value: int64(1234), want: NewFromInt(1234, 100, "EUR")},
+		{name: "bytes", value: []byte("-50"), want: NewFromInt(-50, 100, "EUR")},
+		{name: "string", value: "7", want: NewFromInt(7, 100, "EUR")},
+		{name: "null", value: nil, want: NewZero("EUR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanned := SQLMinorUnits(NewZero("EUR"))
+			require.NoError(t, scanned.Scan(tt.value))
+			assert.True(t, tt.want.Equal(scanned.Price()), "got %s", scanned.Price().String())
+		})
+	}
+
+	var scanned SQLMinorUnits
+	assert.Error(t, scanned.Scan("12.34"))
+	assert.Error(t, scanned.Scan(1.5))
+}
@@ -0,0 +1,84 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+type stripeCurrency struct {
+	// decimals of the Stripe integer amount
+	decimals int
+	// step the integer amount must be a multiple of
+	step int64
+}
+
+// stripeCurrencies lists currencies Stripe does not handle with two decimals,
+// see https://docs.stripe.com/currencies#zero-decimal
+var stripeCurrencies = map[string]stripeCurrency{
+	"BIF": {decimals: 0, step: 1},
+	"CLP": {decimals: 0, step: 1},
+	"DJF": {decimals: 0, step: 1},
+	"GNF": {decimals: 0, step: 1},
+	"JPY": {decimals: 0, step: 1},
+	"KMF": {decimals: 0, step: 1},
+	"KRW": {decimals: 0, step: 1},
+	"MGA": {decimals: 0, step: 1},
+	"PYG": {decimals: 0, step: 1},
+	"RWF": {decimals: 0, step: 1},
+	"VND": {decimals: 0, step: 1},
+	"VUV": {decimals: 0, step: 1},
+	"XAF": {decimals: 0, step: 1},
+	"XOF": {decimals: 0, step: 1},
+	"XPF": {decimals: 0, step: 1},
+	// zero-decimal currencies Stripe expects with two decimals for backwards compatibility
+	"ISK": {decimals: 2, step: 100},
+	"UGX": {decimals: 2, step: 100},
+	// three-decimal currencies must be rounded to the nearest ten
+	"BHD": {decimals: 3, step: 10},
+	"JOD": {decimals: 3, step: 10},
+	"KWD": {decimals: 3, step: 10},
+	"OMR": {decimals: 3, step: 10},
+	"TND": {decimals: 3, step: 10},
+}
+
+// NewFromStripeAmount creates a price from a Stripe integer amount (in the smallest currency unit, e.g. 1234 for 12.34 EUR or 1234 for 1234 JPY).
+// The currency is kept as given, Stripe uses lower case currency codes
+func NewFromStripeAmount(amount int64, currency string) Price {
+	return NewFromInt(amount, stripeCurrencyOf(currency).precision(), currency)
+}
+
+// StripeAmount returns the price as Stripe integer amount, taking zero-decimal and three-decimal currencies into account.
+// The amount is rounded half up to the smallest unit Stripe accepts for the currency
+func (p Price) StripeAmount() (int64, error) {
+	if p.amount.IsInf() {
+		return 0, newDetailedError(ErrNotFinite, "infinite amount cannot be converted to a stripe amount")
+	}
+	sc := stripeCurrencyOf(p.currency)
+
+	// round to the smallest accepted unit, e.g. 0.01 for BHD and 1 for ISK
+	payable := p.GetPayableByRoundingMode(RoundingModeHalfUp, sc.precision()/int(sc.step))
+	amount, _ := payable.amount.Rat(nil)
+	amount.Mul(amount, new(big.Rat).SetInt64(int64(sc.precision())))
+	stripeAmount := roundRat(amount, RoundingModeHalfUp)
+	if !stripeAmount.IsInt64() {
+		return 0, errors.New("amount " + stripeAmount.String() + " exceeds the range of stripe amounts")
+	}
+	return stripeAmount.Int64(), nil
+}
+
+func stripeCurrencyOf(currency string) stripeCurrency {
+	if sc, ok := stripeCurrencies[strings.ToUpper(currency)]; ok {
+		return sc
+	}
+	return stripeCurrency{decimals: 2, step: 1}
+}
+
+// precision returns the factor of the integer amount, e.g. 100 for two decimals
+func (s stripeCurrency) precision() int {
+	precision := 1
+	for i := 0; i < s.decimals; i++ {
+		precision *= 10
+	}
+	return precision
+}
@@ -0,0 +1,40 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_StripeAmount(t *testing.T) {
+	tests := []struct {
+		price    Price
+		expected int64
+	}{
+		{price: NewFromFloat(12.34, "eur"), expected: 1234},
+		{price: NewFromFloat(12.345, "usd"), expected: 1235},
+		{price: NewFromFloat(1234.4, "jpy"), expected: 1234},
+		{price: NewFromFloat(1234.6, "MGA"), expected: 1235},
+		{price: NewFromFloat(1234.5, "isk"), expected: 123500},
+		{price: NewFromFloat(5.124, "bhd"), expected: 5120},
+		{price: NewFromFloat(5.126, "kwd"), expected: 5130},
+		{price: NewFromFloat(-10.5, "eur"), expected: -1050},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.price.String(), func(t *testing.T) {
+			amount, err := tt.price.StripeAmount()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, amount)
+		})
+	}
+}
+
+func TestNewFromStripeAmount(t *testing.T) {
+	assert.Equal(t, "12.34", NewFromStripeAmount(1234, "eur").Amount().Text('f', 2))
+	assert.Equal(t, "1234", NewFromStripeAmount(1234, "jpy").Amount().Text('f', 0))
+	assert.Equal(t, "1235", NewFromStripeAmount(123500, "isk").Amount().Text('f', 0))
+	assert.Equal(t, "5.120", NewFromStripeAmount(5120, "bhd").Amount().Text('f', 3))
+	assert.Equal(t, "eur", NewFromStripeAmount(1234, "eur").Currency())
+}
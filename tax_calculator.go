@@ -0,0 +1,119 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+)
+
+type (
+	// TaxRoundingStrategy defines where the tax of an invoice is rounded
+	TaxRoundingStrategy string
+
+	// TaxCalculator calculates the tax of invoice lines with one rate.
+	// The zero value rounds per line and treats the line prices as net prices
+	TaxCalculator struct {
+		Strategy TaxRoundingStrategy
+		// PricesIncludeTax defines that the line prices are gross prices
+		PricesIncludeTax bool
+	}
+
+	// InvoiceTax is the result of a TaxCalculator, the lines always sum up exactly to the totals
+	InvoiceTax struct {
+		Lines []TaxedPrice
+		Net   Price
+		Tax   Price
+		Gross Price
+	}
+)
+
+const (
+	// TaxRoundingPerLine rounds the tax of every line, the invoice tax is the sum of the line taxes
+	TaxRoundingPerLine TaxRoundingStrategy = "line"
+	// TaxRoundingPerTotal rounds the tax once on the invoice total and distributes it to the lines
+	TaxRoundingPerTotal TaxRoundingStrategy = "total"
+)
+
+// Calculate returns the taxes of the given line prices, all lines must have the same currency and be finite
+func (c TaxCalculator) Calculate(lines []Price, rate TaxRate) (InvoiceTax, error) {
+	if len(lines) == 0 {
+		return InvoiceTax{}, errors.New("no invoice line given")
+	}
+	if err := Prices(lines).guard(); err != nil {
+		return InvoiceTax{}, err
+	}
+	for _, line := range lines {
+		if line.amount.IsInf() {
+			return InvoiceTax{}, newDetailedError(ErrNotFinite, "cannot calculate the tax of infinite amounts")
+		}
+	}
+
+	var taxed []TaxedPrice
+	var err error
+	switch c.Strategy {
+	case "", TaxRoundingPerLine:
+		taxed, err = c.perLine(lines, rate)
+	case TaxRoundingPerTotal:
+		taxed, err = c.perTotal(lines, rate)
+	default:
+		return InvoiceTax{}, errors.New("unknown tax rounding strategy " + string(c.Strategy))
+	}
+	if err != nil {
+		return InvoiceTax{}, err
+	}
+
+	currency := lines[0].Currency()
+	result := InvoiceTax{Lines: taxed, Net: NewZero(currency), Tax: NewZero(currency), Gross: NewZero(currency)}
+	for _, line := range taxed {
+		result.Net = result.Net.ForceAdd(line.Net)
+		result.Tax = result.Tax.ForceAdd(line.Tax)
+		result.Gross = result.Gross.ForceAdd(line.Gross)
+	}
+	result.Net, result.Tax, result.Gross = result.Net.GetPayable(), result.Tax.GetPayable(), result.Gross.GetPayable()
+	return result, nil
+}
+
+func (c TaxCalculator) perLine(lines []Price, rate TaxRate) ([]TaxedPrice, error) {
+	taxed := make([]TaxedPrice, len(lines))
+	for i, line := range lines {
+		var err error
+		if c.PricesIncludeTax {
+			taxed[i], err = TaxedPriceFromGross(line, rate)
+		} else {
+			taxed[i], err = TaxedPriceFromNet(line, rate)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return taxed, nil
+}
+
+func (c TaxCalculator) perTotal(lines []Price, rate TaxRate) ([]TaxedPrice, error) {
+	factor := new(big.Rat).Quo(rate.Percent().Rat(), big.NewRat(100, 1))
+	if c.PricesIncludeTax {
+		factor = new(big.Rat).Quo(rate.Percent().Rat(), new(big.Rat).Add(rate.Percent().Rat(), big.NewRat(100, 1)))
+	}
+
+	payables := make([]Price, len(lines))
+	exactTaxes := make([]*big.Rat, len(lines))
+	for i, line := range lines {
+		payables[i] = line.GetPayable()
+		amount, _ := payables[i].amount.Rat(nil)
+		exactTaxes[i] = amount.Mul(amount, factor)
+	}
+	taxes := lines[0].distributeTax(exactTaxes)
+
+	taxed := make([]TaxedPrice, len(lines))
+	for i, payable := range payables {
+		line := TaxedPrice{Tax: taxes[i], Rate: rate}
+		if c.PricesIncludeTax {
+			line.Gross = payable
+			line.Net = payable.ForceAdd(taxes[i].Inverse()).GetPayable()
+		} else {
+			line.Net = payable
+			line.Gross = payable.ForceAdd(taxes[i]).GetPayable()
+		}
+		taxed[i] = line
+	}
+	return taxed, nil
+}
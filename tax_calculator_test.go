@@ -0,0 +1,93 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxCalculator_Calculate(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(1900)
+	// each line has an exact tax of 0.1805, rounded per line 0.18 each
+	lines := []Price{NewFromInt(95, 100, "EUR"), NewFromInt(95, 100, "EUR"), NewFromInt(95, 100, "EUR")}
+
+	tests := []struct {
+		name      string
+		strategy  TaxRoundingStrategy
+		wantTax   string
+		wantGross string
+	}{
+		{name: "default is per line", strategy: "", wantTax: "0.54", wantGross: "3.39"},
+		{name: "per line", strategy: TaxRoundingPerLine, wantTax: "0.54", wantGross: "3.39"},
+		{name: "per total", strategy: TaxRoundingPerTotal, wantTax: "0.54", wantGross: "3.39"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TaxCalculator{Strategy: tt.strategy}.Calculate(lines, rate)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTax, result.Tax.Amount().Text('f', 2))
+			assert.Equal(t, tt.wantGross, result.Gross.Amount().Text('f', 2))
+			assert.Equal(t, "2.85", result.Net.Amount().Text('f', 2))
+			for _, line := range result.Lines {
+				assert.NoError(t, line.Validate())
+			}
+		})
+	}
+}
+
+func TestTaxCalculator_StrategiesDiffer(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(1000)
+	// each line has an exact tax of 0.005
+	lines := []Price{NewFromInt(5, 100, "EUR"), NewFromInt(5, 100, "EUR"), NewFromInt(5, 100, "EUR")}
+
+	perLine, err := TaxCalculator{Strategy: TaxRoundingPerLine}.Calculate(lines, rate)
+	require.NoError(t, err)
+	assert.Equal(t, "0.03", perLine.Tax.Amount().Text('f', 2))
+
+	perTotal, err := TaxCalculator{Strategy: TaxRoundingPerTotal}.Calculate(lines, rate)
+	require.NoError(t, err)
+	assert.Equal(t, "0.02", perTotal.Tax.Amount().Text('f', 2))
+
+	lineSum := NewZero("EUR")
+	for _, line := range perTotal.Lines {
+		lineSum = lineSum.ForceAdd(line.Tax)
+		assert.NoError(t, line.Validate())
+	}
+	assert.Equal(t, "0.02", lineSum.GetPayable().Amount().Text('f', 2))
+}
+
+func TestTaxCalculator_PricesIncludeTax(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(700)
+	lines := []Price{NewFromInt(1099, 100, "EUR"), NewFromInt(499, 100, "EUR")}
+
+	for _, strategy := range []TaxRoundingStrategy{TaxRoundingPerLine, TaxRoundingPerTotal} {
+		result, err := TaxCalculator{Strategy: strategy, PricesIncludeTax: true}.Calculate(lines, rate)
+		require.NoError(t, err)
+		assert.Equal(t, "15.98", result.Gross.Amount().Text('f', 2), strategy)
+		assert.Equal(t, "1.05", result.Tax.Amount().Text('f', 2), strategy)
+		assert.Equal(t, "14.93", result.Net.Amount().Text('f', 2), strategy)
+	}
+}
+
+func TestTaxCalculator_Errors(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(700)
+
+	_, err := TaxCalculator{}.Calculate(nil, rate)
+	assert.Error(t, err)
+
+	_, err = TaxCalculator{}.Calculate([]Price{NewFromInt(1, 1, "EUR"), NewFromInt(1, 1, "USD")}, rate)
+	assert.Error(t, err)
+
+	_, err = TaxCalculator{Strategy: "invoice"}.Calculate([]Price{NewFromInt(1, 1, "EUR")}, rate)
+	assert.Error(t, err)
+
+	inf := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+	for _, strategy := range []TaxRoundingStrategy{TaxRoundingPerLine, TaxRoundingPerTotal} {
+		for _, includeTax := range []bool{false, true} {
+			_, err = TaxCalculator{Strategy: strategy, PricesIncludeTax: includeTax}.Calculate([]Price{NewFromInt(1, 1, "EUR"), inf}, rate)
+			assert.ErrorIs(t, err, ErrNotFinite)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package price
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// TaxRate is an exact, non negative tax rate (e.g. 19 or 7.7 percent) - it is immutable.
+// Construct it from basis points or a decimal string, so rates like 19% never turn into 19.000001% by float construction
+type TaxRate struct {
+	percent Percent
+}
+
+// NewTaxRateFromBasisPoints creates a TaxRate from basis points, e.g. 1900 for 19% or 770 for 7.7%
+func NewTaxRateFromBasisPoints(bp int64) (TaxRate, error) {
+	if bp < 0 {
+		return TaxRate{}, errors.New("tax rate must not be negative")
+	}
+	return TaxRate{percent: NewPercentFromBasisPoints(bp)}, nil
+}
+
+// ParseTaxRate parses a decimal string like "19", "7.7" or "7.7%"
+func ParseTaxRate(s string) (TaxRate, error) {
+	percent, err := ParsePercent(s)
+	if err != nil {
+		return TaxRate{}, err
+	}
+	if percent.rat.Sign() < 0 {
+		return TaxRate{}, errors.New("tax rate must not be negative")
+	}
+	return TaxRate{percent: percent}, nil
+}
+
+// Percent returns the rate as Percent, e.g. for TaxedBy
+func (r TaxRate) Percent() Percent {
+	return r.percent
+}
+
+// String returns the rate with a percent sign, e.g. "7.7%"
+func (r TaxRate) String() string {
+	return r.percent.String()
+}
+
+// IsZero returns true for a zero rate
+func (r TaxRate) IsZero() bool {
+	return r.percent.IsZero()
+}
+
+// Equal compares the rates exact
+func (r TaxRate) Equal(cmp TaxRate) bool {
+	return r.percent.Equal(cmp.percent)
+}
+
+// TaxFromNet returns the tax amount of a net price
+func (r TaxRate) TaxFromNet(net Price) Price {
+	return net.TaxFromNetBy(r.percent)
+}
+
+// TaxFromGross returns the tax amount included in a gross price
+func (r TaxRate) TaxFromGross(gross Price) Price {
+	return gross.TaxFromGrossBy(r.percent)
+}
+
+// Gross returns the net price increased by the tax
+func (r TaxRate) Gross(net Price) Price {
+	return net.TaxedBy(r.percent)
+}
+
+// MarshalJSON encodes the rate as decimal string, e.g. "7.7"
+func (r TaxRate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.percent.decimalString())
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON number
+func (r *TaxRate) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+		s = n.String()
+	}
+	parsed, err := ParseTaxRate(s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, the rate is stored as decimal string
+func (r TaxRate) Value() (driver.Value, error) {
+	return r.percent.decimalString(), nil
+}
+
+// Scan implements sql.Scanner for decimal strings and numbers
+func (r *TaxRate) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return errors.New("unsupported type for tax rate")
+	}
+	parsed, err := ParseTaxRate(s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// InferTaxPercent derives the tax percentage from a net and gross price, e.g. 19 for 100.00 and 119.00.
+// Rounded prices only allow an approximation of the original rate, round the result as needed
+func InferTaxPercent(net, gross Price) (big.Float, error) {
+	if net.currency != gross.currency {
+		return big.Float{}, ErrCurrencyMismatch
+	}
+	tax, err := gross.Sub(net)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return tax.PercentageOf(net)
+}
@@ -0,0 +1,71 @@
+package price
+
+import (
+	"errors"
+	"strings"
+)
+
+type (
+	// TaxJurisdiction identifies where and for what a tax rate applies.
+	// Region is optional (e.g. a state or canton) and ProductClass is the tax class of a product, e.g. "standard" or "reduced"
+	TaxJurisdiction struct {
+		Country      string
+		Region       string
+		ProductClass string
+	}
+
+	// TaxRateProvider returns the tax rate of a jurisdiction
+	TaxRateProvider interface {
+		TaxRate(jurisdiction TaxJurisdiction) (TaxRate, error)
+	}
+
+	// TaxRateProviderFunc is a function implementing TaxRateProvider
+	TaxRateProviderFunc func(jurisdiction TaxJurisdiction) (TaxRate, error)
+
+	// StaticTaxRates is a TaxRateProvider with fixed rates, countries and regions are matched case-insensitive.
+	// A rate without Region is used for all regions of the country that have no own rate
+	StaticTaxRates map[TaxJurisdiction]TaxRate
+)
+
+// TaxRate calls f(jurisdiction)
+func (f TaxRateProviderFunc) TaxRate(jurisdiction TaxJurisdiction) (TaxRate, error) {
+	return f(jurisdiction)
+}
+
+// TaxRate returns the rate of the jurisdiction, falling back to the country wide rate of the product class
+func (s StaticTaxRates) TaxRate(jurisdiction TaxJurisdiction) (TaxRate, error) {
+	if rate, ok := s.lookup(jurisdiction); ok {
+		return rate, nil
+	}
+	if jurisdiction.Region != "" {
+		countryWide := jurisdiction
+		countryWide.Region = ""
+		if rate, ok := s.lookup(countryWide); ok {
+			return rate, nil
+		}
+	}
+	return TaxRate{}, errors.New("no tax rate for " + jurisdiction.String())
+}
+
+func (s StaticTaxRates) lookup(jurisdiction TaxJurisdiction) (TaxRate, bool) {
+	if rate, ok := s[jurisdiction]; ok {
+		return rate, true
+	}
+	for key, rate := range s {
+		if strings.EqualFold(key.Country, jurisdiction.Country) &&
+			strings.EqualFold(key.Region, jurisdiction.Region) &&
+			key.ProductClass == jurisdiction.ProductClass {
+			return rate, true
+		}
+	}
+	return TaxRate{}, false
+}
+
+// String returns the jurisdiction like "DE/standard" or "CH-ZH/reduced"
+func (j TaxJurisdiction) String() string {
+	location := j.Country
+	if j.Region != "" {
+		location += "-" + j.Region
+	}
+	return location + "/" + j.ProductClass
+}
@@ -0,0 +1,60 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTaxRate(t *testing.T, s string) TaxRate {
+	t.Helper()
+	rate, err := ParseTaxRate(s)
+	require.NoError(t, err)
+	return rate
+}
+
+func TestStaticTaxRates_TaxRate(t *testing.T) {
+	rates := StaticTaxRates{
+		{Country: "DE", ProductClass: "standard"}:               mustTaxRate(t, "19"),
+		{Country: "DE", ProductClass: "reduced"}:                mustTaxRate(t, "7"),
+		{Country: "US", Region: "CA", ProductClass: "standard"}: mustTaxRate(t, "7.25"),
+		{Country: "US", Region: "NY", ProductClass: "standard"}: mustTaxRate(t, "4"),
+		{Country: "CH", ProductClass: "standard"}:               mustTaxRate(t, "8.1"),
+	}
+
+	tests := []struct {
+		name         string
+		jurisdiction TaxJurisdiction
+		want         string
+	}{
+		{name: "country", jurisdiction: TaxJurisdiction{Country: "DE", ProductClass: "standard"}, want: "19%"},
+		{name: "product class", jurisdiction: TaxJurisdiction{Country: "DE", ProductClass: "reduced"}, want: "7%"},
+		{name: "case-insensitive", jurisdiction: TaxJurisdiction{Country: "de", ProductClass: "reduced"}, want: "7%"},
+		{name: "region", jurisdiction: TaxJurisdiction{Country: "US", Region: "CA", ProductClass: "standard"}, want: "7.25%"},
+		{name: "region fallback to country", jurisdiction: TaxJurisdiction{Country: "CH", Region: "ZH", ProductClass: "standard"}, want: "8.1%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := rates.TaxRate(tt.jurisdiction)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rate.String())
+		})
+	}
+
+	_, err := rates.TaxRate(TaxJurisdiction{Country: "US", Region: "TX", ProductClass: "standard"})
+	assert.EqualError(t, err, "no tax rate for US-TX/standard")
+
+	_, err = rates.TaxRate(TaxJurisdiction{Country: "DE", ProductClass: "luxury"})
+	assert.Error(t, err)
+}
+
+func TestTaxRateProviderFunc(t *testing.T) {
+	var provider TaxRateProvider = TaxRateProviderFunc(func(jurisdiction TaxJurisdiction) (TaxRate, error) {
+		return NewTaxRateFromBasisPoints(1900)
+	})
+
+	rate, err := provider.TaxRate(TaxJurisdiction{Country: "DE"})
+	require.NoError(t, err)
+	assert.True(t, rate.Gross(NewFromInt(100, 1, "EUR")).Equal(NewFromInt(119, 1, "EUR")))
+}
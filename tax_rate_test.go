@@ -0,0 +1,105 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxRate_Constructors(t *testing.T) {
+	rate, err := NewTaxRateFromBasisPoints(770)
+	require.NoError(t, err)
+	assert.Equal(t, "7.7%", rate.String())
+
+	parsed, err := ParseTaxRate("7.7%")
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(rate))
+
+	parsed, err = ParseTaxRate("19")
+	require.NoError(t, err)
+	assert.True(t, parsed.Percent().Equal(NewPercent(19)))
+
+	_, err = NewTaxRateFromBasisPoints(-1)
+	assert.Error(t, err)
+
+	_, err = ParseTaxRate("-19")
+	assert.Error(t, err)
+
+	_, err = ParseTaxRate("abc")
+	assert.Error(t, err)
+
+	assert.True(t, TaxRate{}.IsZero())
+}
+
+func TestTaxRate_Calculations(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(1900)
+
+	assert.True(t, rate.TaxFromNet(NewFromInt(100, 1, "EUR")).Equal(NewFromInt(19, 1, "EUR")))
+	assert.True(t, rate.TaxFromGross(NewFromInt(119, 1, "EUR")).Equal(NewFromInt(19, 1, "EUR")))
+	assert.True(t, rate.Gross(NewFromInt(100, 1, "EUR")).Equal(NewFromInt(119, 1, "EUR")))
+}
+
+func TestTaxRate_JSON(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(770)
+
+	data, err := json.Marshal(rate)
+	require.NoError(t, err)
+	assert.Equal(t, `"7.7"`, string(data))
+
+	var decoded TaxRate
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.Equal(rate))
+
+	require.NoError(t, json.Unmarshal([]byte(`19`), &decoded))
+	assert.Equal(t, "19%", decoded.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`"-1"`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &decoded))
+}
+
+func TestTaxRate_ValueScan(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(770)
+
+	value, err := rate.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "7.7", value)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "bytes", value: []byte("7.7"), want: "7.7%"},
+		{name: "string", value: "19", want: "19%"},
+		{name: "int", value: int64(7), want: "7%"},
+		{name: "float", value: 7.7, want: "7.7%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scanned TaxRate
+			require.NoError(t, scanned.Scan(tt.value))
+			assert.Equal(t, tt.want, scanned.String())
+		})
+	}
+
+	var scanned TaxRate
+	assert.Error(t, scanned.Scan(true))
+}
+
+func TestInferTaxPercent(t *testing.T) {
+	percent, err := InferTaxPercent(NewFromInt(100, 1, "EUR"), NewFromInt(119, 1, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "19", percent.Text('f', -1))
+
+	percent, err = InferTaxPercent(NewFromInt(1027, 100, "EUR"), NewFromInt(1099, 100, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "7.01", percent.Text('f', 2))
+
+	_, err = InferTaxPercent(NewZero("EUR"), NewFromInt(1, 1, "EUR"))
+	assert.Error(t, err)
+
+	_, err = InferTaxPercent(NewFromInt(100, 1, "EUR"), NewFromInt(119, 1, "USD"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,184 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+type (
+	// TaxShare is the portion of a price taxed with Percent, the portion is defined by Weight relative to the other shares
+	TaxShare struct {
+		Percent Percent
+		Weight  int64
+	}
+
+	// TaxTable splits a price across multiple tax rates, e.g. a bundle with 19% and 7% parts
+	TaxTable []TaxShare
+
+	// TaxBreakdownLine is the part of a TaxBreakdown taxed with Percent
+	TaxBreakdownLine struct {
+		Percent Percent
+		Net     Price
+		Tax     Price
+		Gross   Price
+	}
+
+	// TaxBreakdown is the result of splitting a price with a TaxTable, all amounts are payable
+	// and the lines sum up exactly to Net, Tax and Gross
+	TaxBreakdown struct {
+		Lines []TaxBreakdownLine
+		Net   Price
+		Tax   Price
+		Gross Price
+	}
+)
+
+// FromNet splits the net price by the weights of the table and calculates the tax of each part.
+// The total tax is rounded once and distributed to the lines, so the line taxes sum up exactly to it.
+func (t TaxTable) FromNet(net Price) (TaxBreakdown, error) {
+	if err := t.validate(); err != nil {
+		return TaxBreakdown{}, err
+	}
+	if net.amount.IsInf() {
+		return TaxBreakdown{}, newDetailedError(ErrNotFinite, "cannot split the tax of infinite amounts")
+	}
+	nets, err := net.SplitByRatios(t.weights())
+	if err != nil {
+		return TaxBreakdown{}, err
+	}
+
+	exactTaxes := make([]*big.Rat, len(t))
+	for i, share := range t {
+		amount, _ := nets[i].amount.Rat(nil)
+		exactTaxes[i] = amount.Mul(amount, share.Percent.Rat())
+		exactTaxes[i].Quo(exactTaxes[i], big.NewRat(100, 1))
+	}
+	taxes := net.distributeTax(exactTaxes)
+
+	breakdown := TaxBreakdown{Lines: make([]TaxBreakdownLine, len(t))}
+	for i, share := range t {
+		breakdown.Lines[i] = TaxBreakdownLine{
+			Percent: share.Percent,
+			Net:     nets[i],
+			Tax:     taxes[i],
+			Gross:   nets[i].ForceAdd(taxes[i]),
+		}
+	}
+	return breakdown.withTotals(net.Currency())
+}
+
+// FromGross splits the gross price by the weights of the table and calculates the included tax of each part.
+// The total tax is rounded once and distributed to the lines, so the line taxes sum up exactly to it.
+func (t TaxTable) FromGross(gross Price) (TaxBreakdown, error) {
+	if err := t.validate(); err != nil {
+		return TaxBreakdown{}, err
+	}
+	if gross.amount.IsInf() {
+		return TaxBreakdown{}, newDetailedError(ErrNotFinite, "cannot split the tax of infinite amounts")
+	}
+	grosses, err := gross.SplitByRatios(t.weights())
+	if err != nil {
+		return TaxBreakdown{}, err
+	}
+
+	exactTaxes := make([]*big.Rat, len(t))
+	for i, share := range t {
+		amount, _ := grosses[i].amount.Rat(nil)
+		percent100 := new(big.Rat).Add(share.Percent.Rat(), big.NewRat(100, 1))
+		exactTaxes[i] = amount.Mul(amount, share.Percent.Rat())
+		exactTaxes[i].Quo(exactTaxes[i], percent100)
+	}
+	taxes := gross.distributeTax(exactTaxes)
+
+	breakdown := TaxBreakdown{Lines: make([]TaxBreakdownLine, len(t))}
+	for i, share := range t {
+		net, err := grosses[i].Sub(taxes[i])
+		if err != nil {
+			return TaxBreakdown{}, err
+		}
+		breakdown.Lines[i] = TaxBreakdownLine{
+			Percent: share.Percent,
+			Net:     net,
+			Tax:     taxes[i],
+			Gross:   grosses[i],
+		}
+	}
+	return breakdown.withTotals(gross.Currency())
+}
+
+// distributeTax rounds the sum of the exact taxes to a payable amount and distributes it with the largest remainder method:
+// each tax is rounded down and the remaining cents go to the taxes with the largest discarded fractions
+func (p Price) distributeTax(exactTaxes []*big.Rat) []Price {
+	mode, precision := p.payableRoundingPrecision()
+	scale := new(big.Rat).SetInt64(int64(precision))
+
+	total := new(big.Rat)
+	units := make([]*big.Int, len(exactTaxes))
+	fractions := make([]*big.Rat, len(exactTaxes))
+	distributed := new(big.Int)
+	for i, tax := range exactTaxes {
+		total.Add(total, tax)
+		scaled := new(big.Rat).Mul(tax, scale)
+		units[i] = roundRat(scaled, RoundingModeFloor)
+		fractions[i] = scaled.Sub(scaled, new(big.Rat).SetInt(units[i]))
+		distributed.Add(distributed, units[i])
+	}
+
+	// the remainder is between 0 and the number of taxes, since every tax was rounded down by less than one unit
+	remainder := new(big.Int).Sub(roundRat(total.Mul(total, scale), mode), distributed).Int64()
+	order := make([]int, len(exactTaxes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return fractions[order[i]].Cmp(fractions[order[j]]) > 0
+	})
+	for _, i := range order[:remainder] {
+		units[i].Add(units[i], big.NewInt(1))
+	}
+
+	taxes := make([]Price, len(exactTaxes))
+	for i, unit := range units {
+		taxes[i] = p.withRat(new(big.Rat).SetFrac(unit, big.NewInt(int64(precision))))
+	}
+	return taxes
+}
+
+func (b TaxBreakdown) withTotals(currency string) (TaxBreakdown, error) {
+	b.Net, b.Tax, b.Gross = NewZero(currency), NewZero(currency), NewZero(currency)
+	var err error
+	for _, line := range b.Lines {
+		if b.Net, err = b.Net.Add(line.Net); err != nil {
+			return TaxBreakdown{}, err
+		}
+		if b.Tax, err = b.Tax.Add(line.Tax); err != nil {
+			return TaxBreakdown{}, err
+		}
+		if b.Gross, err = b.Gross.Add(line.Gross); err != nil {
+			return TaxBreakdown{}, err
+		}
+	}
+	b.Net, b.Tax, b.Gross = b.Net.GetPayable(), b.Tax.GetPayable(), b.Gross.GetPayable()
+	return b, nil
+}
+
+func (t TaxTable) weights() []int64 {
+	weights := make([]int64, len(t))
+	for i, share := range t {
+		weights[i] = share.Weight
+	}
+	return weights
+}
+
+func (t TaxTable) validate() error {
+	if len(t) == 0 {
+		return errors.New("tax table has no shares")
+	}
+	for _, share := range t {
+		if share.Percent.Rat().Sign() < 0 {
+			return errors.New("tax percent must not be negative")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxTable_FromNet(t *testing.T) {
+	table := TaxTable{
+		{Percent: NewPercent(19), Weight: 1},
+		{Percent: NewPercent(7), Weight: 1},
+	}
+
+	breakdown, err := table.FromNet(NewFromInt(1001, 100, "EUR"))
+	require.NoError(t, err)
+	require.Len(t, breakdown.Lines, 2)
+
+	// nets 5.01 and 5.00, exact taxes 0.9519 and 0.35, total 1.3019 rounded to 1.30
+	assert.Equal(t, "5.01", breakdown.Lines[0].Net.Amount().Text('f', 2))
+	assert.Equal(t, "5.00", breakdown.Lines[1].Net.Amount().Text('f', 2))
+	assert.Equal(t, "0.95", breakdown.Lines[0].Tax.Amount().Text('f', 2))
+	assert.Equal(t, "0.35", breakdown.Lines[1].Tax.Amount().Text('f', 2))
+	assert.Equal(t, "1.30", breakdown.Tax.Amount().Text('f', 2))
+	assert.Equal(t, "10.01", breakdown.Net.Amount().Text('f', 2))
+	assert.Equal(t, "11.31", breakdown.Gross.Amount().Text('f', 2))
+	assert.Equal(t, "5.96", breakdown.Lines[0].Gross.Amount().Text('f', 2))
+}
+
+func TestTaxTable_FromNetDistributesRounding(t *testing.T) {
+	// three lines with exact taxes of 0.005 each, rounded per line 0.03 but in total 0.02
+	table := TaxTable{
+		{Percent: NewPercent(10), Weight: 1},
+		{Percent: NewPercent(10), Weight: 1},
+		{Percent: NewPercent(10), Weight: 1},
+	}
+	breakdown, err := table.FromNet(NewFromInt(15, 100, "EUR"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "0.02", breakdown.Tax.Amount().Text('f', 2))
+	sum := NewZero("EUR")
+	for _, line := range breakdown.Lines {
+		sum = sum.ForceAdd(line.Tax)
+		assert.True(t, line.Tax.IsPayable())
+	}
+	assert.True(t, sum.LikelyEqual(breakdown.Tax))
+}
+
+func TestTaxTable_FromGross(t *testing.T) {
+	table := TaxTable{
+		{Percent: NewPercent(19), Weight: 3},
+		{Percent: NewPercent(7), Weight: 1},
+	}
+
+	breakdown, err := table.FromGross(NewFromInt(100, 1, "EUR"))
+	require.NoError(t, err)
+
+	// gross 75 and 25, taxes 11.9748 and 1.6355, total 13.6103 rounded to 13.61
+	assert.Equal(t, "75.00", breakdown.Lines[0].Gross.Amount().Text('f', 2))
+	assert.Equal(t, "25.00", breakdown.Lines[1].Gross.Amount().Text('f', 2))
+	assert.Equal(t, "11.97", breakdown.Lines[0].Tax.Amount().Text('f', 2))
+	assert.Equal(t, "1.64", breakdown.Lines[1].Tax.Amount().Text('f', 2))
+	assert.Equal(t, "13.61", breakdown.Tax.Amount().Text('f', 2))
+	assert.Equal(t, "86.39", breakdown.Net.Amount().Text('f', 2))
+	assert.Equal(t, "100.00", breakdown.Gross.Amount().Text('f', 2))
+}
+
+func TestTaxTable_Errors(t *testing.T) {
+	_, err := TaxTable{}.FromNet(NewFromInt(1, 1, "EUR"))
+	assert.Error(t, err)
+
+	_, err = TaxTable{{Percent: NewPercent(-1), Weight: 1}}.FromGross(NewFromInt(1, 1, "EUR"))
+	assert.Error(t, err)
+
+	_, err = TaxTable{{Percent: NewPercent(19), Weight: 0}}.FromNet(NewFromInt(1, 1, "EUR"))
+	assert.Error(t, err)
+
+	breakdown, err := TaxTable{{Percent: NewPercent(0), Weight: 1}}.FromNet(NewFromInt(1, 1, "EUR"))
+	require.NoError(t, err)
+	assert.True(t, breakdown.Tax.IsZero())
+
+	inf := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+	table := TaxTable{{Percent: NewPercent(19), Weight: 1}, {Percent: NewPercent(7), Weight: 1}}
+	_, err = table.FromNet(inf)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = table.FromGross(inf)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
@@ -0,0 +1,71 @@
+package price
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// TaxedPrice holds the payable net, tax and gross amounts of a price taxed with Rate, Net plus Tax always equals Gross
+type TaxedPrice struct {
+	Net   Price   `json:"net"`
+	Tax   Price   `json:"tax"`
+	Gross Price   `json:"gross"`
+	Rate  TaxRate `json:"rate"`
+}
+
+// TaxedPriceFromNet calculates the payable tax and gross amounts of a net price
+func TaxedPriceFromNet(net Price, rate TaxRate) (TaxedPrice, error) {
+	payableNet := net.GetPayable()
+	tax := rate.TaxFromNet(payableNet).GetPayable()
+	gross, err := payableNet.Add(tax)
+	if err != nil {
+		return TaxedPrice{}, err
+	}
+	return TaxedPrice{Net: payableNet, Tax: tax, Gross: gross.GetPayable(), Rate: rate}, nil
+}
+
+// TaxedPriceFromGross calculates the payable tax and net amounts of a gross price
+func TaxedPriceFromGross(gross Price, rate TaxRate) (TaxedPrice, error) {
+	payableGross := gross.GetPayable()
+	tax := rate.TaxFromGross(payableGross).GetPayable()
+	net, err := payableGross.Sub(tax)
+	if err != nil {
+		return TaxedPrice{}, err
+	}
+	return TaxedPrice{Net: net.GetPayable(), Tax: tax, Gross: payableGross, Rate: rate}, nil
+}
+
+// Currency returns the currency of the prices
+func (t TaxedPrice) Currency() string {
+	return t.Gross.Currency()
+}
+
+// Validate returns an error if the currencies differ or Net plus Tax does not equal Gross
+func (t TaxedPrice) Validate() error {
+	if t.Net.Currency() != t.Gross.Currency() || t.Tax.Currency() != t.Gross.Currency() {
+		return newDetailedError(ErrCurrencyMismatch, "taxed price amounts must have the same currency")
+	}
+	sum, err := t.Net.Add(t.Tax)
+	if err != nil {
+		return err
+	}
+	if !sum.GetPayable().Equal(t.Gross.GetPayable()) {
+		return errors.New("net plus tax must equal gross")
+	}
+	return nil
+}
+
+// UnmarshalJSON implements encode Unmarshaler and rejects inconsistent amounts
+func (t *TaxedPrice) UnmarshalJSON(data []byte) error {
+	// taxedPrice avoids the recursion into UnmarshalJSON
+	type taxedPrice TaxedPrice
+	var decoded taxedPrice
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if err := TaxedPrice(decoded).Validate(); err != nil {
+		return err
+	}
+	*t = TaxedPrice(decoded)
+	return nil
+}
@@ -0,0 +1,55 @@
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxedPriceFromNet(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(1900)
+
+	taxed, err := TaxedPriceFromNet(NewFromInt(1099, 100, "EUR"), rate)
+	require.NoError(t, err)
+	assert.Equal(t, "10.99", taxed.Net.Amount().Text('f', 2))
+	assert.Equal(t, "2.09", taxed.Tax.Amount().Text('f', 2))
+	assert.Equal(t, "13.08", taxed.Gross.Amount().Text('f', 2))
+	assert.Equal(t, "EUR", taxed.Currency())
+	assert.True(t, taxed.Rate.Equal(rate))
+	assert.NoError(t, taxed.Validate())
+}
+
+func TestTaxedPriceFromGross(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(700)
+
+	taxed, err := TaxedPriceFromGross(NewFromInt(1099, 100, "EUR"), rate)
+	require.NoError(t, err)
+	assert.Equal(t, "10.27", taxed.Net.Amount().Text('f', 2))
+	assert.Equal(t, "0.72", taxed.Tax.Amount().Text('f', 2))
+	assert.Equal(t, "10.99", taxed.Gross.Amount().Text('f', 2))
+	assert.NoError(t, taxed.Validate())
+}
+
+func TestTaxedPrice_JSON(t *testing.T) {
+	rate, _ := NewTaxRateFromBasisPoints(1900)
+	taxed, err := TaxedPriceFromNet(NewFromInt(100, 1, "EUR"), rate)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(taxed)
+	require.NoError(t, err)
+
+	var decoded TaxedPrice
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.Net.Equal(taxed.Net))
+	assert.True(t, decoded.Tax.Equal(taxed.Tax))
+	assert.True(t, decoded.Gross.Equal(taxed.Gross))
+	assert.True(t, decoded.Rate.Equal(rate))
+
+	inconsistent := `{"net":{"amount":"100","currency":"EUR"},"tax":{"amount":"19","currency":"EUR"},"gross":{"amount":"120","currency":"EUR"},"rate":"19"}`
+	assert.Error(t, json.Unmarshal([]byte(inconsistent), &decoded))
+
+	mixed := `{"net":{"amount":"100","currency":"EUR"},"tax":{"amount":"19","currency":"USD"},"gross":{"amount":"119","currency":"EUR"},"rate":"19"}`
+	assert.Error(t, json.Unmarshal([]byte(mixed), &decoded))
+}
@@ -0,0 +1,66 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+)
+
+// TextFormat defines the format of Price.MarshalText
+type TextFormat string
+
+const (
+	// TextFormatJSON writes the JSON format of Price.MarshalJSON, e.g. {"amount":"12.34","currency":"EUR"} (default)
+	TextFormatJSON TextFormat = "json"
+	// TextFormatPlain writes the plain text format of EncodeText, e.g. "12.34 EUR"
+	TextFormatPlain TextFormat = "plain"
+)
+
+// textFormat holds the TextFormat used by Price.MarshalText
+var textFormat atomic.Value
+
+// SetTextFormat sets the format used by Price.MarshalText for all prices, pass "" to restore TextFormatJSON.
+// Price.UnmarshalText reads both formats, so the format can be switched without migrating stored text
+func SetTextFormat(format TextFormat) {
+	if format == "" {
+		format = TextFormatJSON
+	}
+	textFormat.Store(format)
+}
+
+func currentTextFormat() TextFormat {
+	format, _ := textFormat.Load().(TextFormat)
+	return format
+}
+
+// EncodeText returns the amount followed by the currency, e.g. "12.34 EUR", or only the amount without currency.
+// Unlike Display the amount is not rounded to the currency, it is the shortest decimal that identifies the binary amount
+// at its precision. DecodeText reads this decimal with 64 bits, so the decoded price has the same text and payable
+// amount but is not Equal if the precision differs, e.g. NewFromFloat(0.1, "EUR") is written as "0.1 EUR".
+// Use MarshalBinary to restore prices exactly
+func EncodeText(p Price) string {
+	amount := p.canonicalAmount()
+	if p.currency == "" {
+		return amount
+	}
+	return amount + " " + p.currency
+}
+
+// DecodeText reads the plain text format of EncodeText, e.g. "12.34 EUR" or "12.34"
+func DecodeText(s string) (Price, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return Price{}, errors.New("invalid price " + s)
+	}
+
+	amount, _, err := new(big.Float).Parse(fields[0], 10)
+	if err != nil {
+		return Price{}, errors.New("invalid amount " + fields[0])
+	}
+	currency := ""
+	if len(fields) == 2 {
+		currency = fields[1]
+	}
+	return NewFromBigFloat(*amount, currency), nil
+}
@@ -0,0 +1,85 @@
+package price
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeText(t *testing.T) {
+	assert.Equal(t, "12.34 EUR", EncodeText(NewFromInt(1234, 100, "EUR")))
+	assert.Equal(t, "-0.005 USD", EncodeText(NewFromInt(-5, 1000, "USD")))
+	assert.Equal(t, "0", EncodeText(NewZero("")))
+	assert.Equal(t, "+Inf EUR", EncodeText(NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")))
+}
+
+func TestDecodeText(t *testing.T) {
+	for _, original := range []Price{
+		NewFromInt(1234, 100, "EUR"),
+		NewFromInt(-5, 1000, "USD"),
+		NewFromFloat(0.1, "JPY"),
+		NewZero(""),
+	} {
+		decoded, err := DecodeText(EncodeText(original))
+		require.NoError(t, err)
+		assert.Equal(t, original.canonicalAmount(), decoded.canonicalAmount())
+		assert.Equal(t, original.Currency(), decoded.Currency())
+	}
+
+	// the text is stable, the decoded amount may have another precision than the original one
+	for _, original := range []Price{NewFromFloat(0.1, "EUR"), NewFromFloat(12.34, "EUR"), NewFromFloat(-1e-7, "USD")} {
+		text := EncodeText(original)
+		decoded, err := DecodeText(text)
+		require.NoError(t, err)
+		assert.Equal(t, text, EncodeText(decoded))
+		assert.True(t, original.GetPayable().Equal(decoded.GetPayable()))
+		assert.True(t, original.LikelyEqual(decoded))
+	}
+	fromFloat, err := DecodeText(EncodeText(NewFromFloat(0.1, "EUR")))
+	require.NoError(t, err)
+	assert.False(t, NewFromFloat(0.1, "EUR").Equal(fromFloat))
+
+	decoded, err := DecodeText("  12.34   EUR ")
+	require.NoError(t, err)
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(decoded))
+
+	for _, s := range []string{"", "EUR", "12.34 EUR x", "12,34 EUR"} {
+		_, err := DecodeText(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSetTextFormat(t *testing.T) {
+	defer SetTextFormat("")
+	p := NewFromInt(1234, 100, "EUR")
+
+	SetTextFormat(TextFormatPlain)
+	text, err := p.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "12.34 EUR", string(text))
+
+	// JSON, String and Value are not affected
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.34","currency":"EUR"}`, string(data))
+	assert.Equal(t, `{"amount":"12.34","currency":"EUR"}`, p.String())
+
+	SetTextFormat("")
+	text, err = p.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"12.34","currency":"EUR"}`, string(text))
+}
+
+func TestPrice_UnmarshalTextFormats(t *testing.T) {
+	var p Price
+	require.NoError(t, p.UnmarshalText([]byte(`{"amount":"12.34","currency":"EUR"}`)))
+	assert.True(t, NewFromInt(1234, 100, "EUR").LikelyEqual(p))
+
+	require.NoError(t, p.UnmarshalText([]byte("5 USD")))
+	assert.True(t, NewFromInt(5, 1, "USD").LikelyEqual(p))
+
+	assert.Error(t, p.UnmarshalText([]byte("five USD")))
+}
@@ -0,0 +1,108 @@
+package price
+
+import (
+	"errors"
+	"sort"
+)
+
+type (
+	// PriceTier is a unit price valid from MinQuantity units on
+	PriceTier struct {
+		MinQuantity int   `json:"minQuantity"`
+		UnitPrice   Price `json:"unitPrice"`
+	}
+
+	// PriceTiers is a quantity break price table sorted by MinQuantity, use NewPriceTiers to create it
+	PriceTiers []PriceTier
+
+	// TierMode defines how the total of a quantity is calculated
+	TierMode string
+)
+
+const (
+	// TierModeVolume charges all units with the unit price of the tier the quantity falls into
+	TierModeVolume TierMode = "volume"
+	// TierModeGraduated charges the units within each tier with the unit price of that tier
+	TierModeGraduated TierMode = "graduated"
+)
+
+// NewPriceTiers returns the validated tiers sorted by MinQuantity,
+// all tiers must have the same currency and distinct minimum quantities of at least 1
+func NewPriceTiers(tiers ...PriceTier) (PriceTiers, error) {
+	if len(tiers) == 0 {
+		return nil, errors.New("no price tier given")
+	}
+	sorted := make(PriceTiers, len(tiers))
+	copy(sorted, tiers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MinQuantity < sorted[j].MinQuantity
+	})
+
+	for i, tier := range sorted {
+		if tier.MinQuantity < 1 {
+			return nil, errors.New("minimum quantity must be at least 1")
+		}
+		if tier.UnitPrice.Currency() != sorted[0].UnitPrice.Currency() {
+			return nil, ErrCurrencyMismatch
+		}
+		if i > 0 && tier.MinQuantity == sorted[i-1].MinQuantity {
+			return nil, errors.New("minimum quantities must be unique")
+		}
+	}
+	return sorted, nil
+}
+
+// Resolve returns the unit price for qty
+func (t PriceTiers) Resolve(qty int) (Price, error) {
+	i, err := t.index(qty)
+	if err != nil {
+		return Price{}, err
+	}
+	return t[i].UnitPrice.Clone(), nil
+}
+
+// Total returns the price for qty units calculated with the given mode.
+// In graduated mode units below the first tier are charged with the first tier's unit price
+func (t PriceTiers) Total(qty int, mode TierMode) (Price, error) {
+	i, err := t.index(qty)
+	if err != nil {
+		return Price{}, err
+	}
+
+	switch mode {
+	case TierModeVolume:
+		return t[i].UnitPrice.Multiply(qty), nil
+	case TierModeGraduated:
+		total := NewZero(t[0].UnitPrice.Currency())
+		for tier := 0; tier <= i; tier++ {
+			upper := qty
+			if tier < i {
+				upper = t[tier+1].MinQuantity - 1
+			}
+			lower := t[tier].MinQuantity
+			if tier == 0 {
+				lower = 1
+			}
+			total, err = total.Add(t[tier].UnitPrice.Multiply(upper - lower + 1))
+			if err != nil {
+				return Price{}, err
+			}
+		}
+		return total, nil
+	}
+	return Price{}, errors.New("unknown tier mode " + string(mode))
+}
+
+// index returns the index of the tier qty falls into
+func (t PriceTiers) index(qty int) (int, error) {
+	if len(t) == 0 {
+		return 0, errors.New("no price tier given")
+	}
+	if qty < t[0].MinQuantity {
+		return 0, errors.New("quantity is below the minimum quantity of the first tier")
+	}
+	i := sort.Search(len(t), func(i int) bool {
+		return t[i].MinQuantity > qty
+	})
+	return i - 1, nil
+}
@@ -0,0 +1,110 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTiers(t *testing.T) PriceTiers {
+	t.Helper()
+	tiers, err := NewPriceTiers(
+		PriceTier{MinQuantity: 11, UnitPrice: NewFromInt(8, 1, "EUR")},
+		PriceTier{MinQuantity: 1, UnitPrice: NewFromInt(10, 1, "EUR")},
+		PriceTier{MinQuantity: 51, UnitPrice: NewFromInt(5, 1, "EUR")},
+	)
+	require.NoError(t, err)
+	return tiers
+}
+
+func TestNewPriceTiers(t *testing.T) {
+	tiers := testTiers(t)
+	assert.Equal(t, []int{1, 11, 51}, []int{tiers[0].MinQuantity, tiers[1].MinQuantity, tiers[2].MinQuantity})
+
+	_, err := NewPriceTiers()
+	assert.Error(t, err)
+
+	_, err = NewPriceTiers(PriceTier{MinQuantity: 0, UnitPrice: NewFromInt(1, 1, "EUR")})
+	assert.Error(t, err)
+
+	_, err = NewPriceTiers(
+		PriceTier{MinQuantity: 1, UnitPrice: NewFromInt(1, 1, "EUR")},
+		PriceTier{MinQuantity: 1, UnitPrice: NewFromInt(2, 1, "EUR")},
+	)
+	assert.Error(t, err)
+
+	_, err = NewPriceTiers(
+		PriceTier{MinQuantity: 1, UnitPrice: NewFromInt(1, 1, "EUR")},
+		PriceTier{MinQuantity: 5, UnitPrice: NewFromInt(2, 1, "USD")},
+	)
+	assert.Error(t, err)
+}
+
+func TestPriceTiers_Resolve(t *testing.T) {
+	tiers := testTiers(t)
+
+	tests := []struct {
+		qty  int
+		want int64
+	}{
+		{qty: 1, want: 10},
+		{qty: 10, want: 10},
+		{qty: 11, want: 8},
+		{qty: 50, want: 8},
+		{qty: 51, want: 5},
+		{qty: 1000, want: 5},
+	}
+	for _, tt := range tests {
+		unitPrice, err := tiers.Resolve(tt.qty)
+		require.NoError(t, err)
+		assert.True(t, unitPrice.Equal(NewFromInt(tt.want, 1, "EUR")), "qty %d", tt.qty)
+	}
+
+	_, err := tiers.Resolve(0)
+	assert.Error(t, err)
+}
+
+func TestPriceTiers_Total(t *testing.T) {
+	tiers := testTiers(t)
+
+	tests := []struct {
+		name string
+		qty  int
+		mode TierMode
+		want int64
+	}{
+		{name: "volume first tier", qty: 5, mode: TierModeVolume, want: 50},
+		{name: "volume second tier", qty: 20, mode: TierModeVolume, want: 160},
+		{name: "volume third tier", qty: 60, mode: TierModeVolume, want: 300},
+		{name: "graduated first tier", qty: 5, mode: TierModeGraduated, want: 50},
+		{name: "graduated second tier", qty: 20, mode: TierModeGraduated, want: 100 + 80},
+		{name: "graduated third tier", qty: 60, mode: TierModeGraduated, want: 100 + 320 + 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := tiers.Total(tt.qty, tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, NewFromInt(tt.want, 1, "EUR").Amount().Text('f', 2), total.Amount().Text('f', 2))
+			assert.Equal(t, "EUR", total.Currency())
+		})
+	}
+
+	_, err := tiers.Total(5, TierMode("unknown"))
+	assert.Error(t, err)
+
+	_, err = tiers.Total(0, TierModeVolume)
+	assert.Error(t, err)
+}
+
+func TestPriceTiers_TotalGraduatedWithMinimumOrder(t *testing.T) {
+	tiers, err := NewPriceTiers(
+		PriceTier{MinQuantity: 10, UnitPrice: NewFromInt(2, 1, "EUR")},
+		PriceTier{MinQuantity: 20, UnitPrice: NewFromInt(1, 1, "EUR")},
+	)
+	require.NoError(t, err)
+
+	total, err := tiers.Total(25, TierModeGraduated)
+	require.NoError(t, err)
+	assert.Equal(t, "44.00", total.Amount().Text('f', 2))
+}
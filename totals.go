@@ -0,0 +1,124 @@
+package price
+
+import (
+	"errors"
+	"time"
+)
+
+type (
+	// TotalsCalculator calculates the totals of line items.
+	// The zero value treats prices as net prices, rounds tax per line, stacks discounts sequentially and evaluates them now
+	TotalsCalculator struct {
+		// TaxRounding defines where tax is rounded, see TaxCalculator
+		TaxRounding TaxRoundingStrategy
+		// PricesIncludeTax defines that the unit prices are gross prices
+		PricesIncludeTax bool
+		// Stacking defines how the discounts of a line are combined, defaults to StackingSequential
+		Stacking StackingMode
+		// At is the time discounts are evaluated at, defaults to now
+		At time.Time
+	}
+
+	// RateTotal is the total of all lines taxed with Rate
+	RateTotal struct {
+		Rate  TaxRate
+		Net   Price
+		Tax   Price
+		Gross Price
+	}
+
+	// Totals is the result of a TotalsCalculator, all amounts are payable
+	Totals struct {
+		// Subtotal is the sum of all rows before discounts
+		Subtotal Price
+		// DiscountTotal is the sum of all applied discounts
+		DiscountTotal Price
+		// TaxTotal is the sum of all taxes
+		TaxTotal Price
+		// TaxByRate holds the totals per tax rate in order of their first occurrence
+		TaxByRate []RateTotal
+		// NetTotal is the grand total without tax
+		NetTotal Price
+		// GrandTotal is the amount to pay
+		GrandTotal Price
+		// Charges holds the grand total as ChargeTypeMain charge
+		Charges Charges
+	}
+)
+
+// Calculate returns the totals of the items, all items must have the same currency
+func (c TotalsCalculator) Calculate(items []LineItem) (Totals, error) {
+	if len(items) == 0 {
+		return Totals{}, errors.New("no line item given")
+	}
+	currency := items[0].UnitPrice.Currency()
+	at := c.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	stacking := c.Stacking
+	if stacking == "" {
+		stacking = StackingSequential
+	}
+
+	totals := Totals{
+		Subtotal:      NewZero(currency),
+		DiscountTotal: NewZero(currency),
+	}
+
+	// rows grouped by rate, rates keep the order of their first occurrence
+	var rates []TaxRate
+	rowsByRate := map[string][]Price{}
+	for _, item := range items {
+		if item.UnitPrice.Currency() != currency {
+			return Totals{}, ErrCurrencyMismatch
+		}
+		if item.Quantity < 0 {
+			return Totals{}, errors.New("quantity must not be negative")
+		}
+		if item.UnitPrice.amount.IsInf() {
+			return Totals{}, newDetailedError(ErrNotFinite, "unit price must be finite")
+		}
+
+		row := item.RowSubtotal()
+		discounted, applied, err := item.applyDiscounts(stacking, at)
+		if err != nil {
+			return Totals{}, err
+		}
+		totals.Subtotal = totals.Subtotal.ForceAdd(row)
+		for _, discount := range applied {
+			totals.DiscountTotal = totals.DiscountTotal.ForceAdd(discount.Amount)
+		}
+
+		key := item.TaxRate.String()
+		if _, ok := rowsByRate[key]; !ok {
+			rates = append(rates, item.TaxRate)
+		}
+		rowsByRate[key] = append(rowsByRate[key], discounted)
+	}
+
+	calculator := TaxCalculator{Strategy: c.TaxRounding, PricesIncludeTax: c.PricesIncludeTax}
+	totals.TaxTotal, totals.NetTotal, totals.GrandTotal = NewZero(currency), NewZero(currency), NewZero(currency)
+	for _, rate := range rates {
+		tax, err := calculator.Calculate(rowsByRate[rate.String()], rate)
+		if err != nil {
+			return Totals{}, err
+		}
+		totals.TaxByRate = append(totals.TaxByRate, RateTotal{Rate: rate, Net: tax.Net, Tax: tax.Tax, Gross: tax.Gross})
+		totals.TaxTotal = totals.TaxTotal.ForceAdd(tax.Tax)
+		totals.NetTotal = totals.NetTotal.ForceAdd(tax.Net)
+		totals.GrandTotal = totals.GrandTotal.ForceAdd(tax.Gross)
+	}
+
+	totals.Subtotal = totals.Subtotal.GetPayable()
+	totals.DiscountTotal = totals.DiscountTotal.GetPayable()
+	totals.TaxTotal = totals.TaxTotal.GetPayable()
+	totals.NetTotal = totals.NetTotal.GetPayable()
+	totals.GrandTotal = totals.GrandTotal.GetPayable()
+	totals.Charges = Charges{}.AddCharge(Charge{
+		Type:  ChargeTypeMain,
+		Price: totals.GrandTotal,
+		Value: totals.GrandTotal,
+	})
+	return totals, nil
+}
@@ -0,0 +1,92 @@
+package price
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalsCalculator_Calculate(t *testing.T) {
+	standard := mustTaxRate(t, "19")
+	reduced := mustTaxRate(t, "7")
+
+	items := []LineItem{
+		{UnitPrice: NewFromInt(1000, 100, "EUR"), Quantity: 3, TaxRate: standard, Discounts: Discounts{{Percentage: 10}}},
+		{UnitPrice: NewFromInt(250, 100, "EUR"), Quantity: 2, TaxRate: reduced},
+		{UnitPrice: NewFromInt(500, 100, "EUR"), Quantity: 1, TaxRate: standard, Discounts: Discounts{{Price: NewFromInt(1, 1, "EUR")}}},
+	}
+
+	totals, err := TotalsCalculator{}.Calculate(items)
+	require.NoError(t, err)
+
+	assert.Equal(t, "40.00", totals.Subtotal.Amount().Text('f', 2))
+	assert.Equal(t, "4.00", totals.DiscountTotal.Amount().Text('f', 2))
+	assert.Equal(t, "36.00", totals.NetTotal.Amount().Text('f', 2))
+
+	require.Len(t, totals.TaxByRate, 2)
+	assert.True(t, totals.TaxByRate[0].Rate.Equal(standard))
+	assert.Equal(t, "31.00", totals.TaxByRate[0].Net.Amount().Text('f', 2))
+	assert.Equal(t, "5.89", totals.TaxByRate[0].Tax.Amount().Text('f', 2))
+	assert.True(t, totals.TaxByRate[1].Rate.Equal(reduced))
+	assert.Equal(t, "0.35", totals.TaxByRate[1].Tax.Amount().Text('f', 2))
+
+	assert.Equal(t, "6.24", totals.TaxTotal.Amount().Text('f', 2))
+	assert.Equal(t, "42.24", totals.GrandTotal.Amount().Text('f', 2))
+
+	main, found := totals.Charges.GetByType(ChargeTypeMain)
+	require.True(t, found)
+	assert.True(t, main.Price.Equal(totals.GrandTotal))
+}
+
+func TestTotalsCalculator_PricesIncludeTax(t *testing.T) {
+	items := []LineItem{
+		{UnitPrice: NewFromInt(1190, 100, "EUR"), Quantity: 2, TaxRate: mustTaxRate(t, "19")},
+	}
+
+	totals, err := TotalsCalculator{PricesIncludeTax: true}.Calculate(items)
+	require.NoError(t, err)
+	assert.Equal(t, "23.80", totals.GrandTotal.Amount().Text('f', 2))
+	assert.Equal(t, "3.80", totals.TaxTotal.Amount().Text('f', 2))
+	assert.Equal(t, "20.00", totals.NetTotal.Amount().Text('f', 2))
+}
+
+func TestTotalsCalculator_DiscountTime(t *testing.T) {
+	until := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	items := []LineItem{
+		{UnitPrice: NewFromInt(100, 1, "EUR"), Quantity: 1, Discounts: Discounts{{Percentage: 10, ValidUntil: &until}}},
+	}
+
+	totals, err := TotalsCalculator{At: until.Add(-time.Hour)}.Calculate(items)
+	require.NoError(t, err)
+	assert.Equal(t, "90.00", totals.GrandTotal.Amount().Text('f', 2))
+
+	totals, err = TotalsCalculator{At: until}.Calculate(items)
+	require.NoError(t, err)
+	assert.Equal(t, "100.00", totals.GrandTotal.Amount().Text('f', 2))
+}
+
+func TestTotalsCalculator_Errors(t *testing.T) {
+	_, err := TotalsCalculator{}.Calculate(nil)
+	assert.Error(t, err)
+
+	_, err = TotalsCalculator{}.Calculate([]LineItem{
+		{UnitPrice: NewFromInt(1, 1, "EUR"), Quantity: 1},
+		{UnitPrice: NewFromInt(1, 1, "USD"), Quantity: 1},
+	})
+	assert.Error(t, err)
+
+	_, err = TotalsCalculator{}.Calculate([]LineItem{{UnitPrice: NewFromInt(1, 1, "EUR"), Quantity: -1}})
+	assert.Error(t, err)
+
+	inf := NewFromBigFloat(*new(big.Float).SetInf(false), "EUR")
+	for _, rounding := range []TaxRoundingStrategy{TaxRoundingPerLine, TaxRoundingPerTotal} {
+		_, err = TotalsCalculator{TaxRounding: rounding}.Calculate([]LineItem{
+			{UnitPrice: NewFromInt(1, 1, "EUR"), Quantity: 1, TaxRate: mustTaxRate(t, "19")},
+			{UnitPrice: inf, Quantity: 1, TaxRate: mustTaxRate(t, "19")},
+		})
+		assert.ErrorIs(t, err, ErrNotFinite)
+	}
+}
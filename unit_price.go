@@ -0,0 +1,117 @@
+package price
+
+import (
+	"errors"
+	"math/big"
+)
+
+// MeasurementUnit is the unit a UnitPrice refers to
+type MeasurementUnit string
+
+// Measurement units supported by UnitPrice
+const (
+	UnitMilligram  MeasurementUnit = "mg"
+	UnitGram       MeasurementUnit = "g"
+	UnitKilogram   MeasurementUnit = "kg"
+	UnitMilliliter MeasurementUnit = "ml"
+	UnitCentiliter MeasurementUnit = "cl"
+	UnitLiter      MeasurementUnit = "l"
+	UnitMillimeter MeasurementUnit = "mm"
+	UnitCentimeter MeasurementUnit = "cm"
+	UnitMeter      MeasurementUnit = "m"
+	UnitPiece      MeasurementUnit = "piece"
+)
+
+// measurementUnits maps the units to their dimension and the number of base units (mg, ml, mm, piece) they represent
+var measurementUnits = map[MeasurementUnit]struct {
+	dimension string
+	factor    int64
+}{
+	UnitMilligram:  {dimension: "mass", factor: 1},
+	UnitGram:       {dimension: "mass", factor: 1000},
+	UnitKilogram:   {dimension: "mass", factor: 1000000},
+	UnitMilliliter: {dimension: "volume", factor: 1},
+	UnitCentiliter: {dimension: "volume", factor: 10},
+	UnitLiter:      {dimension: "volume", factor: 1000},
+	UnitMillimeter: {dimension: "length", factor: 1},
+	UnitCentimeter: {dimension: "length", factor: 10},
+	UnitMeter:      {dimension: "length", factor: 1000},
+	UnitPiece:      {dimension: "count", factor: 1},
+}
+
+// UnitPrice is the price of a quantity of a unit, e.g. 1.99 EUR per 100 g
+type UnitPrice struct {
+	Price    Price           `json:"price"`
+	Quantity int64           `json:"quantity"`
+	Unit     MeasurementUnit `json:"unit"`
+}
+
+// NewUnitPrice returns a validated unit price
+func NewUnitPrice(price Price, quantity int64, unit MeasurementUnit) (UnitPrice, error) {
+	u := UnitPrice{Price: price, Quantity: quantity, Unit: unit}
+	if err := u.validate(); err != nil {
+		return UnitPrice{}, err
+	}
+	return u, nil
+}
+
+// Normalize returns the exact price for one toUnit, e.g. 1.99 EUR per 100 g normalized to kg is 19.90 EUR per kg
+func (u UnitPrice) Normalize(toUnit MeasurementUnit) (UnitPrice, error) {
+	perBase, err := u.perBaseUnit()
+	if err != nil {
+		return UnitPrice{}, err
+	}
+	target, ok := measurementUnits[toUnit]
+	if !ok {
+		return UnitPrice{}, errors.New("unknown measurement unit " + string(toUnit))
+	}
+	if target.dimension != measurementUnits[u.Unit].dimension {
+		return UnitPrice{}, errors.New("cannot convert " + string(u.Unit) + " into " + string(toUnit))
+	}
+	return UnitPrice{
+		Price:    u.Price.withRat(perBase.Mul(perBase, big.NewRat(target.factor, 1))),
+		Quantity: 1,
+		Unit:     toUnit,
+	}, nil
+}
+
+// ComparePerUnit compares the prices per unit and returns -1, 0 or 1 if u is cheaper, equal or more expensive than other
+func (u UnitPrice) ComparePerUnit(other UnitPrice) (int, error) {
+	if u.Price.Currency() != other.Price.Currency() {
+		return 0, newDetailedError(ErrCurrencyMismatch, "cannot compare prices in different currencies")
+	}
+	if err := other.validate(); err != nil {
+		return 0, err
+	}
+	if measurementUnits[u.Unit].dimension != measurementUnits[other.Unit].dimension {
+		return 0, errors.New("cannot compare " + string(u.Unit) + " with " + string(other.Unit))
+	}
+	own, err := u.perBaseUnit()
+	if err != nil {
+		return 0, err
+	}
+	others, _ := other.perBaseUnit()
+	return own.Cmp(others), nil
+}
+
+// perBaseUnit returns the exact price of one base unit
+func (u UnitPrice) perBaseUnit() (*big.Rat, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+	amount, _ := u.Price.amount.Rat(nil)
+	return amount.Quo(amount, big.NewRat(u.Quantity*measurementUnits[u.Unit].factor, 1)), nil
+}
+
+func (u UnitPrice) validate() error {
+	if u.Quantity <= 0 {
+		return errors.New("quantity must be higher than zero")
+	}
+	if _, ok := measurementUnits[u.Unit]; !ok {
+		return errors.New("unknown measurement unit " + string(u.Unit))
+	}
+	if u.Price.amount.IsInf() {
+		return newDetailedError(ErrNotFinite, "unit price must be finite")
+	}
+	return nil
+}
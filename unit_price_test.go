@@ -0,0 +1,65 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitPrice_Normalize(t *testing.T) {
+	per100g, err := NewUnitPrice(NewFromInt(199, 100, "EUR"), 100, UnitGram)
+	require.NoError(t, err)
+
+	perKg, err := per100g.Normalize(UnitKilogram)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), perKg.Quantity)
+	assert.Equal(t, UnitKilogram, perKg.Unit)
+	assert.Equal(t, "19.90", perKg.Price.Amount().Text('f', 2))
+
+	perLiter, err := NewUnitPrice(NewFromInt(129, 100, "EUR"), 75, UnitCentiliter)
+	require.NoError(t, err)
+	normalized, err := perLiter.Normalize(UnitLiter)
+	require.NoError(t, err)
+	assert.Equal(t, "1.72", normalized.Price.Amount().Text('f', 2))
+
+	_, err = per100g.Normalize(UnitLiter)
+	assert.Error(t, err)
+
+	_, err = per100g.Normalize("oz")
+	assert.Error(t, err)
+}
+
+func TestUnitPrice_ComparePerUnit(t *testing.T) {
+	small, _ := NewUnitPrice(NewFromInt(199, 100, "EUR"), 250, UnitGram)
+	large, _ := NewUnitPrice(NewFromInt(699, 100, "EUR"), 1, UnitKilogram)
+	same, _ := NewUnitPrice(NewFromInt(796, 100, "EUR"), 1, UnitKilogram)
+
+	result, err := small.ComparePerUnit(large)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result, "7.96 per kg is more expensive than 6.99 per kg")
+
+	result, err = large.ComparePerUnit(small)
+	require.NoError(t, err)
+	assert.Equal(t, -1, result)
+
+	result, err = small.ComparePerUnit(same)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result)
+
+	liquid, _ := NewUnitPrice(NewFromInt(1, 1, "EUR"), 1, UnitLiter)
+	_, err = small.ComparePerUnit(liquid)
+	assert.Error(t, err)
+
+	usd, _ := NewUnitPrice(NewFromInt(1, 1, "USD"), 1, UnitKilogram)
+	_, err = small.ComparePerUnit(usd)
+	assert.Error(t, err)
+}
+
+func TestNewUnitPrice_Validation(t *testing.T) {
+	_, err := NewUnitPrice(NewFromInt(1, 1, "EUR"), 0, UnitGram)
+	assert.Error(t, err)
+
+	_, err = NewUnitPrice(NewFromInt(1, 1, "EUR"), 1, "oz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package price
+
+import (
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+type (
+	// priceXML is the XML format of a Price, e.g. <price currency="EUR">12.34</price>
+	priceXML struct {
+		Currency string `xml:"currency,attr,omitempty"`
+		Amount   string `xml:",chardata"`
+	}
+
+	// chargeXML is the XML format of a Charge
+	chargeXML struct {
+		Type      string          `xml:"type,attr"`
+		Reference string          `xml:"reference,attr,omitempty"`
+		Price     Price           `xml:"price"`
+		Value     Price           `xml:"value"`
+		Metadata  []chargeMetaXML `xml:"metadata,omitempty"`
+	}
+
+	chargeMetaXML struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:",chardata"`
+	}
+)
+
+// MarshalXML writes the price as <price currency="EUR">12.34</price>, the element name is taken from the field tag.
+// The amount is written exactly without exponent
+func (p Price) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if p.amount.IsInf() {
+		return ErrNotFinite
+	}
+	return e.EncodeElement(priceXML{Currency: p.currency, Amount: p.canonicalAmount()}, defaultXMLName(start, "price"))
+}
+
+// UnmarshalXML reads <price currency="EUR">12.34</price>. Without currency attribute the currency may follow
+// the amount like in Google Shopping feeds, e.g. <g:price>12.34 EUR</g:price>. An empty element is a zero price
+func (p *Price) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var px priceXML
+	if err := d.DecodeElement(&px, &start); err != nil {
+		return err
+	}
+
+	amount, currency := strings.TrimSpace(px.Amount), px.Currency
+	if fields := strings.Fields(amount); currency == "" && len(fields) == 2 {
+		amount, currency = fields[0], fields[1]
+	}
+	if amount == "" {
+		*p = NewZero(currency)
+		return nil
+	}
+
+	am, _, err := new(big.Float).Parse(amount, 10)
+	if err != nil {
+		return errors.New("invalid amount " + amount)
+	}
+	p.amount = *am
+	p.currency = currency
+	return nil
+}
+
+// MarshalXML writes the charge as <charge type="main"><price currency="EUR">12.34</price><value currency="EUR">12.34</value></charge>,
+// metadata is written as <metadata key="provider">value</metadata> elements ordered by key
+func (c Charge) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	cx := chargeXML{
+		Type:      c.Type,
+		Reference: c.Reference,
+		Price:     c.Price,
+		Value:     c.Value,
+	}
+	keys := make([]string, 0, len(c.Metadata))
+	for key := range c.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		cx.Metadata = append(cx.Metadata, chargeMetaXML{Key: key, Value: c.Metadata[key]})
+	}
+	return e.EncodeElement(cx, defaultXMLName(start, "charge"))
+}
+
+// UnmarshalXML reads a charge written by MarshalXML
+func (c *Charge) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var cx chargeXML
+	if err := d.DecodeElement(&cx, &start); err != nil {
+		return err
+	}
+
+	*c = Charge{
+		Type:      cx.Type,
+		Reference: cx.Reference,
+		Price:     cx.Price,
+		Value:     cx.Value,
+	}
+	if len(cx.Metadata) > 0 {
+		c.Metadata = make(map[string]string, len(cx.Metadata))
+		for _, meta := range cx.Metadata {
+			c.Metadata[meta.Key] = meta.Value
+		}
+	}
+	return nil
+}
+
+// defaultXMLName replaces the element name derived from the type name (e.g. "Price" for xml.Marshal(p)) with name
+func defaultXMLName(start xml.StartElement, name string) xml.StartElement {
+	if start.Name.Local == "" || (start.Name.Space == "" && strings.EqualFold(start.Name.Local, name)) {
+		start.Name.Local = name
+	}
+	return start
+}
@@ -0,0 +1,73 @@
+package price_test
+
+import (
+	"encoding/xml"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maohieng/go-price"
+)
+
+func TestPrice_MarshalXML(t *testing.T) {
+	data, err := xml.Marshal(price.NewFromInt(1234, 100, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, `<price currency="EUR">12.34</price>`, string(data))
+
+	type item struct {
+		XMLName   xml.Name    `xml:"item"`
+		SalePrice price.Price `xml:"sale_price"`
+	}
+	data, err = xml.Marshal(item{SalePrice: price.NewFromFloat(-0.5, "USD")})
+	require.NoError(t, err)
+	assert.Equal(t, `<item><sale_price currency="USD">-0.5</sale_price></item>`, string(data))
+
+	_, err = xml.Marshal(price.NewFromBigFloat(*new(big.Float).SetInf(false), "EUR"))
+	assert.Error(t, err)
+}
+
+func TestPrice_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected price.Price
+	}{
+		{data: `<price currency="EUR">12.34</price>`, expected: price.NewFromInt(1234, 100, "EUR")},
+		{data: `<price currency="EUR"> 12.34 </price>`, expected: price.NewFromInt(1234, 100, "EUR")},
+		{data: `<g:price xmlns:g="http://base.google.com/ns/1.0">12.34 EUR</g:price>`, expected: price.NewFromInt(1234, 100, "EUR")},
+		{data: `<price currency="JPY"/>`, expected: price.NewZero("JPY")},
+	}
+	for _, tt := range tests {
+		var p price.Price
+		require.NoError(t, xml.Unmarshal([]byte(tt.data), &p), tt.data)
+		assert.True(t, tt.expected.Equal(p), tt.data)
+	}
+
+	var p price.Price
+	assert.Error(t, xml.Unmarshal([]byte(`<price currency="EUR">abc</price>`), &p))
+}
+
+func TestCharge_XML(t *testing.T) {
+	charge := price.Charge{
+		Type:      price.ChargeTypeGiftCard,
+		Reference: "card-1",
+		Price:     price.NewFromInt(500, 100, "USD"),
+		Value:     price.NewFromInt(450, 100, "EUR"),
+		Metadata:  map[string]string{"provider": "acme", "batch": "7"},
+	}
+
+	data, err := xml.Marshal(charge)
+	require.NoError(t, err)
+	assert.Equal(t, `<charge type="giftcard" reference="card-1">`+
+		`<price currency="USD">5</price><value currency="EUR">4.5</value>`+
+		`<metadata key="batch">7</metadata><metadata key="provider">acme</metadata></charge>`, string(data))
+
+	var decoded price.Charge
+	require.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, charge.Type, decoded.Type)
+	assert.Equal(t, charge.Reference, decoded.Reference)
+	assert.Equal(t, charge.Metadata, decoded.Metadata)
+	assert.True(t, charge.Price.Equal(decoded.Price))
+	assert.True(t, charge.Value.Equal(decoded.Value))
+}